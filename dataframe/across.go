@@ -0,0 +1,60 @@
+package dataframe
+
+import (
+	"logarithmotechnia/vector"
+	"sort"
+	"strings"
+)
+
+// AcrossColumns holds columns selector, functions and a name pattern created by Across.
+type AcrossColumns struct {
+	selector    any
+	fns         map[string]func(vector.Vector) vector.Vector
+	namePattern string
+}
+
+// Across creates an argument for Mutate and Summarize which applies every function to every selected column.
+// The selector can be anything acceptable by Select or a slice of such selectors ([]any). Grouping columns of
+// a grouped dataframe are never selected.
+//
+// Names of the resulting columns are created from the name pattern where "{col}" is replaced by the column name
+// and "{fn}" is replaced by the function name (a key of the map). The default pattern is "{col}_{fn}". Columns
+// are ordered by selected columns and then by function names.
+func Across(selector any, fns map[string]func(vector.Vector) vector.Vector, namePattern string) AcrossColumns {
+	if namePattern == "" {
+		namePattern = "{col}_{fn}"
+	}
+
+	return AcrossColumns{
+		selector:    selector,
+		fns:         fns,
+		namePattern: namePattern,
+	}
+}
+
+func (df *Dataframe) expandAcross(across AcrossColumns) []Column {
+	selectors := []any{across.selector}
+	if arr, ok := across.selector.([]any); ok {
+		selectors = arr
+	}
+
+	fnNames := make([]string, 0, len(across.fns))
+	for name := range across.fns {
+		fnNames = append(fnNames, name)
+	}
+	sort.Strings(fnNames)
+
+	columns := []Column{}
+	for _, colName := range df.Select(selectors...).columnNames {
+		if strPosInSlice(df.groupedBy, colName) != -1 {
+			continue
+		}
+
+		for _, fnName := range fnNames {
+			name := strings.NewReplacer("{col}", colName, "{fn}", fnName).Replace(across.namePattern)
+			columns = append(columns, Column{name, across.fns[fnName](df.Cn(colName))})
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,79 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestAcross(t *testing.T) {
+	df := New([]Column{
+		{"group", vector.String([]string{"x", "y", "x", "y"})},
+		{"a", vector.Integer([]int{1, 2, 3, 4})},
+		{"b", vector.Float([]float64{0.5, 1.5, 2.5, 3.5})},
+		{"c", vector.String([]string{"p", "q", "r", "s"})},
+	})
+	grouped := df.GroupBy("group")
+
+	fns := map[string]func(vector.Vector) vector.Vector{
+		"max": func(vec vector.Vector) vector.Vector { return vec.Max() },
+		"sum": func(vec vector.Vector) vector.Vector { return vec.Sum() },
+	}
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		names   []string
+		columns []vector.Vector
+	}{
+		{
+			name:  "summarize",
+			df:    grouped.Summarize(Across([]string{"a", "b"}, fns, "")),
+			names: []string{"a_max", "a_sum", "b_max", "b_sum", "group"},
+			columns: []vector.Vector{
+				vector.Integer([]int{3, 4}),
+				vector.Integer([]int{4, 6}),
+				vector.Float([]float64{2.5, 3.5}),
+				vector.Float([]float64{3, 5}),
+				vector.String([]string{"x", "y"}),
+			},
+		},
+		{
+			name: "summarize with pattern and grouping column selected",
+			df: grouped.Summarize(Across(FromToColIndices{1, 2}, map[string]func(vector.Vector) vector.Vector{
+				"total": fns["sum"],
+			}, "{fn}_of_{col}")),
+			names: []string{"total_of_a", "group"},
+			columns: []vector.Vector{
+				vector.Integer([]int{4, 6}),
+				vector.String([]string{"x", "y"}),
+			},
+		},
+		{
+			name: "mutate",
+			df: df.Mutate(Across([]any{"a", 3}, map[string]func(vector.Vector) vector.Vector{
+				"double": func(vec vector.Vector) vector.Vector { return vec.Mul(vector.Integer([]int{2})) },
+			}, "{col}")),
+			names: []string{"group", "a", "b", "c"},
+			columns: []vector.Vector{
+				vector.String([]string{"x", "y", "x", "y"}),
+				vector.Integer([]int{2, 4, 6, 8}),
+				vector.Float([]float64{1, 3, 5, 7}),
+				vector.String([]string{"p", "q", "r", "s"}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.df.columnNames, data.names) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					data.df.columnNames, data.names))
+			}
+			if !vector.CompareVectorArrs(data.df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", data.df.columns, data.columns))
+			}
+		})
+	}
+}
@@ -9,6 +9,14 @@ const KeyOptionArrangeReverse = "arrange_reverse"
 const KeyOptionArrangeReverseColumns = "arrange_reverse_columns"
 const KeyOptionJoinBy = "join_by_columns"
 const KeyOptionVectorOptions = "vector_options"
+const KeyOptionDistinctKeepAll = "distinct_keep_all"
+const KeyOptionCorMethod = "cor_method"
+const KeyOptionCountSort = "count_sort"
+const KeyOptionCountWeight = "count_weight"
+const KeyOptionCountProportions = "count_proportions"
+const KeyOptionSampleReplace = "sample_replace"
+const KeyOptionSampleWeight = "sample_weight"
+const KeyOptionSampleSeed = "sample_seed"
 
 // Option interface
 type Option interface {
@@ -89,3 +97,35 @@ func OptionJoinBy(by ...string) Option {
 func OptionVectorOptions(options []vector.Option) Option {
 	return ConfOption{KeyOptionVectorOptions, options}
 }
+
+func OptionDistinctKeepAll(keepAll bool) Option {
+	return ConfOption{KeyOptionDistinctKeepAll, keepAll}
+}
+
+func OptionCorMethod(method string) Option {
+	return ConfOption{KeyOptionCorMethod, method}
+}
+
+func OptionCountSort(sort bool) Option {
+	return ConfOption{KeyOptionCountSort, sort}
+}
+
+func OptionCountWeight(column string) Option {
+	return ConfOption{KeyOptionCountWeight, column}
+}
+
+func OptionCountProportions(proportions bool) Option {
+	return ConfOption{KeyOptionCountProportions, proportions}
+}
+
+func OptionSampleReplace(replace bool) Option {
+	return ConfOption{KeyOptionSampleReplace, replace}
+}
+
+func OptionSampleWeight(column string) Option {
+	return ConfOption{KeyOptionSampleWeight, column}
+}
+
+func OptionSampleSeed(seed int64) Option {
+	return ConfOption{KeyOptionSampleSeed, seed}
+}
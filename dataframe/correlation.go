@@ -0,0 +1,52 @@
+package dataframe
+
+import (
+	"logarithmotechnia/vector"
+)
+
+// CorMatrix returns a dataframe with pairwise correlations between numeric (integer, int32, int64, uint8, float,
+// float32 and decimal) columns. The first column ("column") contains names of numeric columns and every next column
+// contains correlations of the corresponding numeric column with them. NA-values are handled pairwise: for every
+// pair of columns only rows where both values are not NA are used.
+//
+// Possible options are:
+//   - OptionCorMethod(method) - vector.CorPearson (default), vector.CorSpearman or vector.CorKendall.
+func (df *Dataframe) CorMatrix(options ...Option) *Dataframe {
+	conf := MergeOptions(options)
+
+	method := vector.CorPearson
+	if conf.HasOption(KeyOptionCorMethod) {
+		method = conf.Value(KeyOptionCorMethod).(string)
+	}
+
+	names := []string{}
+	columns := []vector.Vector{}
+	for i, column := range df.columns {
+		if vector.IsNumeric(column) {
+			names = append(names, df.columnNames[i])
+			columns = append(columns, column)
+		}
+	}
+
+	corColumns := make([]vector.Vector, len(columns)+1)
+	corColumns[0] = vector.String(names)
+
+	values := make([][]float64, len(columns))
+	na := make([][]bool, len(columns))
+	for i := range columns {
+		values[i] = make([]float64, len(columns))
+		na[i] = make([]bool, len(columns))
+	}
+
+	for i := range columns {
+		for j := i; j < len(columns); j++ {
+			cor, corNA := vector.Cor(columns[i], columns[j], method).Floats()
+			values[i][j], values[j][i] = cor[0], cor[0]
+			na[i][j], na[j][i] = corNA[0], corNA[0]
+		}
+
+		corColumns[i+1] = vector.FloatWithNA(values[i], na[i])
+	}
+
+	return New(corColumns, OptionColumnNames(append([]string{"column"}, names...)))
+}
@@ -0,0 +1,71 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_CorMatrix(t *testing.T) {
+	df := New([]Column{
+		{"A", vector.Integer([]int{1, 2, 3, 4})},
+		{"B", vector.String([]string{"a", "b", "c", "d"})},
+		{"C", vector.FloatWithNA([]float64{2, 4, 0, 8}, []bool{false, false, true, false})},
+		{"D", vector.Integer([]int{4, 3, 2, 1})},
+	})
+
+	testData := []struct {
+		name    string
+		options []Option
+		columns []vector.Vector
+	}{
+		{
+			name: "pearson",
+			columns: []vector.Vector{
+				vector.String([]string{"A", "C", "D"}),
+				vector.Float([]float64{1, 1, -1}),
+				vector.Float([]float64{1, 1, -1}),
+				vector.Float([]float64{-1, -1, 1}),
+			},
+		},
+		{
+			name:    "kendall",
+			options: []Option{OptionCorMethod(vector.CorKendall)},
+			columns: []vector.Vector{
+				vector.String([]string{"A", "C", "D"}),
+				vector.Float([]float64{1, 1, -1}),
+				vector.Float([]float64{1, 1, -1}),
+				vector.Float([]float64{-1, -1, 1}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			corDf := df.CorMatrix(data.options...)
+
+			if !vector.CompareVectorsForTest(corDf.columns[0], data.columns[0]) {
+				t.Error(fmt.Sprintf("Names (%v) are not equal to expected (%v)", corDf.columns[0], data.columns[0]))
+			}
+
+			for i := 1; i < len(data.columns); i++ {
+				actual, _ := corDf.columns[i].Floats()
+				expected, _ := data.columns[i].Floats()
+				for j := range expected {
+					if math.Abs(actual[j]-expected[j]) > 1e-9 {
+						t.Error(fmt.Sprintf("Column (%v) is not equal to expected (%v)",
+							corDf.columns[i], data.columns[i]))
+						break
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(corDf.columnNames, []string{"column", "A", "C", "D"}) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					corDf.columnNames, []string{"column", "A", "C", "D"}))
+			}
+		})
+	}
+}
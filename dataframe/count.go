@@ -0,0 +1,280 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"logarithmotechnia/vector"
+	"sort"
+	"strconv"
+)
+
+// ValueCounts returns a dataframe with distinct values of the vector in the first column (named after the vector
+// or "value" if the vector has no name) and their counts in the "n" column. NA is counted as a separate value.
+// Values are ordered by their first occurrence.
+//
+// Possible options are the same as for Count() except OptionCountWeight().
+func ValueCounts(vec vector.Vector, options ...Option) *Dataframe {
+	name := vec.Name()
+	if name == "" {
+		name = "value"
+	}
+
+	conf := MergeOptions(options)
+	countOptions := []any{name}
+	for _, key := range []string{KeyOptionCountSort, KeyOptionCountProportions} {
+		if conf.HasOption(key) {
+			countOptions = append(countOptions, ConfOption{key, conf.Value(key)})
+		}
+	}
+
+	return New([]Column{{name, vec.Ungroup()}}).Count(countOptions...)
+}
+
+// Count returns a dataframe with unique combinations of values in selected columns and the number of rows for
+// every combination in the "n" column. If the dataframe is grouped, grouping columns are always used (and go first).
+// Combinations are ordered by their first occurrence and NA-values are considered equal to each other.
+//
+// Acceptable selectors are the same as for Select(). Possible options are:
+//   - OptionCountSort(true) - sort combinations by counts in descending order.
+//   - OptionCountWeight("column") - sum values of the column instead of counting rows. NA-weights are skipped.
+//   - OptionCountProportions(true) - add the "prop" column with shares of counts in the total.
+func (df *Dataframe) Count(arguments ...any) *Dataframe {
+	selectors := []any{}
+	options := []Option{}
+
+	for _, arg := range arguments {
+		switch val := arg.(type) {
+		case Option:
+			options = append(options, val)
+		case []Option:
+			options = append(options, val...)
+		default:
+			selectors = append(selectors, val)
+		}
+	}
+
+	columns := df.GroupedBy()
+	if len(selectors) > 0 {
+		for _, name := range df.Select(selectors...).columnNames {
+			if strPosInSlice(columns, name) == -1 {
+				columns = append(columns, name)
+			}
+		}
+	}
+
+	return df.countByColumns(columns, MergeOptions(options))
+}
+
+// Tally returns the number of rows in every group of a grouped dataframe or in the whole dataframe if it is
+// not grouped. It is the same as Count() without selectors and accepts the same options.
+func (df *Dataframe) Tally(options ...Option) *Dataframe {
+	return df.Count(options)
+}
+
+func (df *Dataframe) countByColumns(columns []string, conf Configuration) *Dataframe {
+	groups := df.rowGroups(columns)
+	if len(columns) == 0 && len(groups) == 0 {
+		// an empty dataframe still has one (empty) group to count
+		groups = [][]int{{}}
+	}
+
+	var weights []float64
+	var weightsNA []bool
+	integerWeights := true
+	if conf.HasOption(KeyOptionCountWeight) && df.HasColumn(conf.Value(KeyOptionCountWeight).(string)) {
+		weightColumn := df.Cn(conf.Value(KeyOptionCountWeight).(string))
+		weights, weightsNA = weightColumn.Floats()
+		integerWeights = weightColumn.Type() == vector.PayloadTypeInteger
+	}
+
+	counts := make([]float64, len(groups))
+	total := 0.0
+	for i, group := range groups {
+		for _, idx := range group {
+			switch {
+			case weights == nil:
+				counts[i]++
+			case !weightsNA[idx-1]:
+				counts[i] += weights[idx-1]
+			}
+		}
+		total += counts[i]
+	}
+
+	order := make([]int, len(groups))
+	for i := range order {
+		order[i] = i
+	}
+	if conf.HasOption(KeyOptionCountSort) && conf.Value(KeyOptionCountSort).(bool) {
+		sort.SliceStable(order, func(i, j int) bool {
+			return counts[order[i]] > counts[order[j]]
+		})
+	}
+
+	firstIndices := make([]int, len(groups))
+	orderedCounts := make([]float64, len(groups))
+	proportions := make([]float64, len(groups))
+	for i, pos := range order {
+		if len(groups[pos]) > 0 {
+			firstIndices[i] = groups[pos][0]
+		}
+		orderedCounts[i] = counts[pos]
+		if total != 0 {
+			proportions[i] = counts[pos] / total
+		}
+	}
+
+	newColumns := []Column{}
+	for _, name := range columns {
+		newColumns = append(newColumns, Column{name, df.Cn(name).Ungroup().ByIndices(firstIndices)})
+	}
+
+	if integerWeights {
+		newColumns = append(newColumns, Column{"n", vector.Float(orderedCounts).AsInteger()})
+	} else {
+		newColumns = append(newColumns, Column{"n", vector.Float(orderedCounts)})
+	}
+
+	if conf.HasOption(KeyOptionCountProportions) && conf.Value(KeyOptionCountProportions).(bool) {
+		newColumns = append(newColumns, Column{"prop", vector.Float(proportions)})
+	}
+
+	return New(newColumns)
+}
+
+// Crosstab returns a contingency table where unique values of rowColumn form rows, unique values of colColumn
+// form columns and cells contain the number of rows with the corresponding combination of values. Values are
+// ordered by their first occurrence, NA is a separate value named "NA". The table has margins: the "Total" column
+// and the "Total" row. The first column contains string representations of rowColumn values. If a value of colColumn
+// is equal to rowColumn, "Total" or another column name, a suffix "_1", "_2", etc. is added to its column name.
+//
+// If valueColumn is not empty, values of this column are passed to the aggregator (for example, a function calling
+// Sum() of the vector) for every cell and margin instead of counting rows. The aggregator has to return a vector of
+// length 1. Cells without values are NA in this case and zero otherwise.
+func (df *Dataframe) Crosstab(
+	rowColumn, colColumn, valueColumn string,
+	aggregator func(vector.Vector) vector.Vector,
+) (*Dataframe, error) {
+	for _, name := range []string{rowColumn, colColumn} {
+		if !df.HasColumn(name) {
+			return nil, errors.New(fmt.Sprintf("column %s does not exist", name))
+		}
+	}
+
+	source := df.Cn(rowColumn).Ungroup()
+	countOnly := valueColumn == ""
+	if countOnly {
+		aggregator = func(vec vector.Vector) vector.Vector {
+			return vector.Integer([]int{vec.Len()})
+		}
+	} else {
+		if !df.HasColumn(valueColumn) {
+			return nil, errors.New(fmt.Sprintf("column %s does not exist", valueColumn))
+		}
+		if aggregator == nil {
+			return nil, errors.New("aggregator is not provided")
+		}
+		source = df.Cn(valueColumn).Ungroup()
+	}
+
+	rowGroups := df.rowGroups([]string{rowColumn})
+	colGroups := df.rowGroups([]string{colColumn})
+
+	colIndex := make([]int, df.rowNum)
+	for col, group := range colGroups {
+		for _, idx := range group {
+			colIndex[idx-1] = col
+		}
+	}
+
+	// the last row and the last column of cells are margins
+	cells := make([][][]int, len(colGroups)+1)
+	for col := range cells {
+		cells[col] = make([][]int, len(rowGroups)+1)
+	}
+	for row, group := range rowGroups {
+		for _, idx := range group {
+			col := colIndex[idx-1]
+			cells[col][row] = append(cells[col][row], idx)
+			cells[len(colGroups)][row] = append(cells[len(colGroups)][row], idx)
+			cells[col][len(rowGroups)] = append(cells[col][len(rowGroups)], idx)
+		}
+	}
+	all := make([]int, df.rowNum)
+	for i := range all {
+		all[i] = i + 1
+	}
+	cells[len(colGroups)][len(rowGroups)] = all
+
+	aggregated := []vector.Vector{}
+	cellIndices := make([][]int, len(cells))
+	for col := range cells {
+		cellIndices[col] = make([]int, len(cells[col]))
+
+		for row, indices := range cells[col] {
+			if len(indices) == 0 {
+				continue
+			}
+
+			value := aggregator(source.ByIndices(indices))
+			if value == nil || value.Len() != 1 {
+				return nil, errors.New(fmt.Sprintf("aggregator returned not a single value for cell (%d, %d)",
+					row+1, col+1))
+			}
+
+			aggregated = append(aggregated, value)
+			cellIndices[col][row] = len(aggregated)
+		}
+	}
+
+	values := vector.Combine(aggregated...)
+	if values == nil {
+		values = vector.Integer([]int{})
+	}
+	if countOnly {
+		values = values.Append(vector.Integer([]int{0}))
+		for col := range cellIndices {
+			for row := range cellIndices[col] {
+				if cellIndices[col][row] == 0 {
+					cellIndices[col][row] = values.Len()
+				}
+			}
+		}
+	}
+
+	rowNames := make([]string, len(rowGroups)+1)
+	rowStrings, rowNA := df.Cn(rowColumn).Strings()
+	for row, group := range rowGroups {
+		rowNames[row] = naString(rowStrings[group[0]-1], rowNA[group[0]-1])
+	}
+	rowNames[len(rowGroups)] = "Total"
+
+	columnNames := []string{rowColumn}
+	columns := []vector.Vector{vector.String(rowNames)}
+
+	usedNames := map[string]bool{rowColumn: true, "Total": true}
+	colStrings, colNA := df.Cn(colColumn).Strings()
+	for col, group := range colGroups {
+		base := naString(colStrings[group[0]-1], colNA[group[0]-1])
+		name := base
+		for id := 1; usedNames[name]; id++ {
+			name = base + "_" + strconv.Itoa(id)
+		}
+		usedNames[name] = true
+
+		columnNames = append(columnNames, name)
+		columns = append(columns, values.ByIndices(cellIndices[col]))
+	}
+	columnNames = append(columnNames, "Total")
+	columns = append(columns, values.ByIndices(cellIndices[len(colGroups)]))
+
+	return New(columns, OptionColumnNames(columnNames)), nil
+}
+
+func naString(str string, na bool) string {
+	if na {
+		return "NA"
+	}
+
+	return str
+}
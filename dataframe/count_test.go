@@ -0,0 +1,177 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_Count(t *testing.T) {
+	df := New([]Column{
+		{"city", vector.StringWithNA([]string{"Paris", "Rome", "Paris", "", "Rome", "Paris"},
+			[]bool{false, false, false, true, false, false})},
+		{"kind", vector.String([]string{"a", "b", "b", "a", "b", "a"})},
+		{"amount", vector.Float([]float64{1.5, 2, 3, 4, 5, 0.5})},
+	})
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		names   []string
+		columns []vector.Vector
+	}{
+		{
+			name:  "value counts",
+			df:    ValueCounts(df.Cn("city")),
+			names: []string{"city", "n"},
+			columns: []vector.Vector{
+				vector.StringWithNA([]string{"Paris", "Rome", ""}, []bool{false, false, true}),
+				vector.Integer([]int{3, 2, 1}),
+			},
+		},
+		{
+			name: "value counts sorted with proportions",
+			df: ValueCounts(vector.Integer([]int{1, 2, 2, 2}), OptionCountSort(true),
+				OptionCountProportions(true)),
+			names: []string{"value", "n", "prop"},
+			columns: []vector.Vector{
+				vector.Integer([]int{2, 1}),
+				vector.Integer([]int{3, 1}),
+				vector.Float([]float64{0.75, 0.25}),
+			},
+		},
+		{
+			name:  "count",
+			df:    df.Count("city", "kind", OptionCountSort(true)),
+			names: []string{"city", "kind", "n"},
+			columns: []vector.Vector{
+				vector.StringWithNA([]string{"Paris", "Rome", "Paris", ""}, []bool{false, false, false, true}),
+				vector.String([]string{"a", "b", "b", "a"}),
+				vector.Integer([]int{2, 2, 1, 1}),
+			},
+		},
+		{
+			name:  "count with weight",
+			df:    df.Count("kind", OptionCountWeight("amount")),
+			names: []string{"kind", "n"},
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b"}),
+				vector.Float([]float64{6, 10}),
+			},
+		},
+		{
+			name:  "tally",
+			df:    df.Tally(),
+			names: []string{"n"},
+			columns: []vector.Vector{
+				vector.Integer([]int{6}),
+			},
+		},
+		{
+			name:  "tally of empty dataframe",
+			df:    df.ByIndices([]int{}).Tally(),
+			names: []string{"n"},
+			columns: []vector.Vector{
+				vector.Integer([]int{0}),
+			},
+		},
+		{
+			name:  "grouped tally",
+			df:    df.GroupBy("kind").Tally(),
+			names: []string{"kind", "n"},
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b"}),
+				vector.Integer([]int{3, 3}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.df.columnNames, data.names) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					data.df.columnNames, data.names))
+			}
+			if !vector.CompareVectorArrs(data.df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", data.df.columns, data.columns))
+			}
+		})
+	}
+}
+
+func TestDataframe_Crosstab(t *testing.T) {
+	df := New([]Column{
+		{"city", vector.String([]string{"Paris", "Rome", "Paris", "Rome", "Paris"})},
+		{"kind", vector.StringWithNA([]string{"a", "b", "b", "", "a"}, []bool{false, false, false, true, false})},
+		{"amount", vector.Integer([]int{1, 2, 3, 4, 5})},
+	})
+
+	testData := []struct {
+		name       string
+		valueCol   string
+		aggregator func(vector.Vector) vector.Vector
+		names      []string
+		columns    []vector.Vector
+	}{
+		{
+			name:  "counts",
+			names: []string{"city", "a", "b", "NA", "Total"},
+			columns: []vector.Vector{
+				vector.String([]string{"Paris", "Rome", "Total"}),
+				vector.Integer([]int{2, 0, 2}),
+				vector.Integer([]int{1, 1, 2}),
+				vector.Integer([]int{0, 1, 1}),
+				vector.Integer([]int{3, 2, 5}),
+			},
+		},
+		{
+			name:     "sums",
+			valueCol: "amount",
+			aggregator: func(vec vector.Vector) vector.Vector {
+				return vec.Sum()
+			},
+			names: []string{"city", "a", "b", "NA", "Total"},
+			columns: []vector.Vector{
+				vector.String([]string{"Paris", "Rome", "Total"}),
+				vector.IntegerWithNA([]int{6, 0, 6}, []bool{false, true, false}),
+				vector.Integer([]int{3, 2, 5}),
+				vector.IntegerWithNA([]int{0, 4, 4}, []bool{true, false, false}),
+				vector.Integer([]int{9, 6, 15}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			table, err := df.Crosstab("city", "kind", data.valueCol, data.aggregator)
+			if err != nil {
+				t.Error(fmt.Sprintf("Error (%v) is not nil", err))
+				return
+			}
+			if !reflect.DeepEqual(table.columnNames, data.names) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)", table.columnNames, data.names))
+			}
+			if !vector.CompareVectorArrs(table.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", table.columns, data.columns))
+			}
+		})
+	}
+
+	clashing := New([]Column{
+		{"city", vector.String([]string{"Paris", "Rome", "Paris", "Rome"})},
+		{"kind", vector.StringWithNA([]string{"Total", "city", "NA", ""}, []bool{false, false, false, true})},
+	})
+	table, err := clashing.Crosstab("city", "kind", "", nil)
+	names := []string{"city", "Total_1", "city_1", "NA", "NA_1", "Total"}
+	if err != nil || !reflect.DeepEqual(table.columnNames, names) {
+		t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)", table.columnNames, names))
+	}
+
+	if _, err := df.Crosstab("city", "missing", "", nil); err == nil {
+		t.Error("Error is nil for a missing column")
+	}
+	if _, err := df.Crosstab("city", "kind", "amount", nil); err == nil {
+		t.Error("Error is nil without an aggregator")
+	}
+}
@@ -1,5 +1,52 @@
 package dataframe
 
-func (df *Dataframe) Distinct(selectors ...any) *Dataframe {
-	return nil
+// Distinct returns a dataframe with unique rows. Uniqueness is determined by the combination of values in selected
+// columns. NA-values are considered equal to each other. The first occurrence of each unique combination is kept
+// and the order of rows is preserved.
+//
+// Acceptable selectors are the same as for Select(). If no selectors are provided, all columns are used.
+//
+// By default only selected columns are returned. Possible options are:
+//   - OptionDistinctKeepAll(true) - keep all other columns (values are taken from the first occurrence).
+func (df *Dataframe) Distinct(arguments ...any) *Dataframe {
+	selectors := []any{}
+	options := []Option{}
+
+	for _, arg := range arguments {
+		switch val := arg.(type) {
+		case Option:
+			options = append(options, val)
+		case []Option:
+			options = append(options, val...)
+		default:
+			selectors = append(selectors, val)
+		}
+	}
+
+	conf := MergeOptions(options)
+	keepAll := conf.HasOption(KeyOptionDistinctKeepAll) && conf.Value(KeyOptionDistinctKeepAll).(bool)
+
+	selected := df.Select(selectors...)
+	if selected.colNum == 0 {
+		return selected
+	}
+
+	indices := df.distinctIndices(selected.columnNames)
+
+	if keepAll {
+		return df.ByIndices(indices)
+	}
+
+	return selected.ByIndices(indices)
+}
+
+func (df *Dataframe) distinctIndices(columns []string) []int {
+	groups := df.rowGroups(columns)
+
+	indices := make([]int, len(groups))
+	for i, group := range groups {
+		indices[i] = group[0]
+	}
+
+	return indices
 }
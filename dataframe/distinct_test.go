@@ -0,0 +1,93 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_Distinct(t *testing.T) {
+	df := New([]Column{
+		{"A", vector.Integer([]int{1, 2, 1, 2, 1, 3, 1})},
+		{"B", vector.StringWithNA([]string{"a", "b", "a", "c", "", "b", ""},
+			[]bool{false, false, false, false, true, false, true})},
+		{"C", vector.Boolean([]bool{true, false, false, false, true, true, false})},
+	})
+
+	testData := []struct {
+		name        string
+		arguments   []any
+		columns     []vector.Vector
+		columnNames []string
+	}{
+		{
+			name:      "all columns",
+			arguments: []any{},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 1, 2, 1, 3, 1}),
+				vector.StringWithNA([]string{"a", "b", "a", "c", "", "b", ""},
+					[]bool{false, false, false, false, true, false, true}),
+				vector.Boolean([]bool{true, false, false, false, true, true, false}),
+			},
+			columnNames: []string{"A", "B", "C"},
+		},
+		{
+			name:      "one column",
+			arguments: []any{"A"},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+			},
+			columnNames: []string{"A"},
+		},
+		{
+			name:      "two columns with na",
+			arguments: []any{"A", "B"},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 2, 1, 3}),
+				vector.StringWithNA([]string{"a", "b", "c", "", "b"}, []bool{false, false, false, true, false}),
+			},
+			columnNames: []string{"A", "B"},
+		},
+		{
+			name:      "indices",
+			arguments: []any{[]int{1, 2}},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 2, 1, 3}),
+				vector.StringWithNA([]string{"a", "b", "c", "", "b"}, []bool{false, false, false, true, false}),
+			},
+			columnNames: []string{"A", "B"},
+		},
+		{
+			name:      "keep all",
+			arguments: []any{"A", "B", OptionDistinctKeepAll(true)},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 2, 1, 3}),
+				vector.StringWithNA([]string{"a", "b", "c", "", "b"}, []bool{false, false, false, true, false}),
+				vector.Boolean([]bool{true, false, false, true, true}),
+			},
+			columnNames: []string{"A", "B", "C"},
+		},
+		{
+			name:        "invalid column",
+			arguments:   []any{"D"},
+			columns:     []vector.Vector{},
+			columnNames: []string{},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			newDf := df.Distinct(data.arguments...)
+
+			if !vector.CompareVectorArrs(newDf.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, data.columns))
+			}
+
+			if !reflect.DeepEqual(newDf.columnNames, data.columnNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					newDf.columnNames, data.columnNames))
+			}
+		})
+	}
+}
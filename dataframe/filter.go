@@ -14,6 +14,8 @@ import (
 //   - a function func(index int, row map[string]any bool which will be called for all rows in the dataframe, but
 //     only those, for which the function will have return true, will be selected.
 //   - a function func(row map[string]any bool - same as previous but without index argument.
+//   - a vector, elements of which are converted to booleans and used in the same way as a boolean slice.
+//     NA-elements (f.e. from comparisons with NA-values) are treated as not selected.
 func (df *Dataframe) Filter(filter any) *Dataframe {
 	switch f := filter.(type) {
 	case []int:
@@ -27,6 +29,10 @@ func (df *Dataframe) Filter(filter any) *Dataframe {
 	case func(map[string]any) bool:
 		indices := util.ToIndices(df.rowNum, df.filterByCompactFunc(f))
 		return df.ByIndices(indices)
+	case vector.Vector:
+		booleans, na := f.Booleans()
+		indices := util.ToIndices(df.rowNum, vector.And(booleans, vector.Not(na)))
+		return df.ByIndices(indices)
 	}
 
 	return New([]vector.Vector{}, df.Options()...)
@@ -52,6 +58,14 @@ func (df *Dataframe) filterByCompactFunc(fn func(map[string]any) bool) []bool {
 	return booleans
 }
 
+// FromTo selects rows of the dataframe in the same way as vector.FromTo does. Contiguous ranges of rows share
+// the data of the columns.
 func (df *Dataframe) FromTo(from, to int) *Dataframe {
-	return df.ByIndices(util.FromTo(from, to, df.RowNum()))
+	newColumns := make([]vector.Vector, df.colNum)
+
+	for i, column := range df.columns {
+		newColumns[i] = column.FromTo(from, to)
+	}
+
+	return New(newColumns, df.OptionsWithNames()...)
 }
@@ -71,6 +71,18 @@ func TestDataframe_Filter(t *testing.T) {
 				vector.BooleanWithNA([]bool{true, true, true, true, true}, nil),
 			},
 		},
+		{
+			name: "boolean vector with NA",
+			selector: vector.BooleanWithNA(
+				[]bool{true, true, true, false, false, false, false, false, false, true},
+				[]bool{false, true, false, false, false, false, false, false, false, false},
+			),
+			dfColumns: []vector.Vector{
+				vector.IntegerWithNA([]int{1, 3, 10}, nil),
+				vector.StringWithNA([]string{"1", "3", "10"}, nil),
+				vector.BooleanWithNA([]bool{true, true, false}, nil),
+			},
+		},
 		{
 			name:      "invalid selector",
 			selector:  []complex128{0 + 0i, 1 + 1i},
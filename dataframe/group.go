@@ -2,6 +2,7 @@ package dataframe
 
 import (
 	"logarithmotechnia/vector"
+	"sort"
 )
 
 // GroupBy transforms the dataframe into a grouped one which later can be used for aggregations.
@@ -60,6 +61,7 @@ func (df *Dataframe) GroupBy(selectors ...any) *Dataframe {
 	}
 	newDf := New(newColumns, options...)
 	newDf.groupedBy = groupByColumns
+	newDf.groupIndex = groups
 
 	return newDf
 }
@@ -93,6 +95,33 @@ func (df *Dataframe) groupByColumn(groupBy string, curGroups [][]int) [][]int {
 	return newIndices
 }
 
+// rowGroups returns groups of rows with the same values in the columns ordered by the first occurrence.
+func (df *Dataframe) rowGroups(columns []string) [][]int {
+	if df.rowNum == 0 {
+		return [][]int{}
+	}
+
+	var groups [][]int
+	for _, column := range columns {
+		groups = df.groupByColumn(column, groups)
+	}
+
+	if len(groups) == 0 {
+		indices := make([]int, df.rowNum)
+		for i := range indices {
+			indices[i] = i + 1
+		}
+
+		return [][]int{indices}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+
+	return groups
+}
+
 // IsGrouped returns true if the dataframe is grouped.
 func (df *Dataframe) IsGrouped() bool {
 	return len(df.groupedBy) > 0
@@ -0,0 +1,70 @@
+package dataframe
+
+import (
+	"logarithmotechnia/vector"
+	"math"
+)
+
+// Histogram splits values of a numeric vector into the number of bins of equal width between the minimum and
+// the maximum of the vector and returns a dataframe with columns "bin" (an ordered factor of intervals), "from",
+// "to" (bounds of the intervals) and "count" (the number of values in the interval). The first interval includes
+// its lower bound and the other ones are right-closed. NA-values are not counted. An empty dataframe is returned
+// if the vector is not numeric, has no values or bins is less than one.
+func Histogram(vec vector.Vector, bins int) *Dataframe {
+	columnNames := OptionColumnNames([]string{"bin", "from", "to", "count"})
+	empty := New([]vector.Vector{
+		vector.String([]string{}),
+		vector.Float([]float64{}),
+		vector.Float([]float64{}),
+		vector.Integer([]int{}),
+	}, columnNames)
+
+	if vec == nil || bins < 1 {
+		return empty
+	}
+
+	floats, na := vec.Floats()
+	min, max := math.Inf(1), math.Inf(-1)
+	for i, val := range floats {
+		if na[i] || math.IsNaN(val) {
+			continue
+		}
+		min = math.Min(min, val)
+		max = math.Max(max, val)
+	}
+	if min > max {
+		return empty
+	}
+	if min == max {
+		min, max = min-0.5, max+0.5
+	}
+
+	edges := make([]float64, bins+1)
+	width := (max - min) / float64(bins)
+	for i := range edges {
+		edges[i] = min + float64(i)*width
+	}
+	edges[bins] = max
+
+	binned := vec.Cut(edges, nil, true, true)
+	if binned.Type() != vector.PayloadTypeFactor {
+		return empty
+	}
+
+	counts := make([]int, bins)
+	codes, codesNA := binned.Integers()
+	for i, code := range codes {
+		if !codesNA[i] {
+			counts[code-1]++
+		}
+	}
+
+	levels := binned.Levels()
+
+	return New([]vector.Vector{
+		vector.String(levels).AsOrderedFactor(vector.OptionLevels(levels...)),
+		vector.Float(edges[:bins]),
+		vector.Float(edges[1:]),
+		vector.Integer(counts),
+	}, columnNames)
+}
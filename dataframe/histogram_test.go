@@ -0,0 +1,63 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	testData := []struct {
+		name    string
+		vec     vector.Vector
+		bins    int
+		columns []vector.Vector
+	}{
+		{
+			name: "integers",
+			vec:  vector.IntegerWithNA([]int{0, 1, 2, 3, 4, 10}, []bool{false, false, false, false, true, false}),
+			bins: 2,
+			columns: []vector.Vector{
+				vector.String([]string{"[0,5]", "(5,10]"}).AsOrderedFactor(vector.OptionLevels("[0,5]", "(5,10]")),
+				vector.Float([]float64{0, 5}),
+				vector.Float([]float64{5, 10}),
+				vector.Integer([]int{4, 1}),
+			},
+		},
+		{
+			name: "single value",
+			vec:  vector.Float([]float64{1, 1}),
+			bins: 1,
+			columns: []vector.Vector{
+				vector.String([]string{"[0.5,1.5]"}).AsOrderedFactor(vector.OptionLevels("[0.5,1.5]")),
+				vector.Float([]float64{0.5}),
+				vector.Float([]float64{1.5}),
+				vector.Integer([]int{2}),
+			},
+		},
+		{
+			name: "not numeric",
+			vec:  vector.String([]string{"a"}),
+			bins: 2,
+			columns: []vector.Vector{
+				vector.String([]string{}),
+				vector.Float([]float64{}),
+				vector.Float([]float64{}),
+				vector.Integer([]int{}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			hist := Histogram(data.vec, data.bins)
+
+			if !vector.CompareVectorArrs(hist.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", hist.columns, data.columns))
+			}
+			if len(hist.columnNames) != 4 || hist.columnNames[0] != "bin" || hist.columnNames[3] != "count" {
+				t.Error(fmt.Sprintf("Column names (%v) are not correct", hist.columnNames))
+			}
+		})
+	}
+}
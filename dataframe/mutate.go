@@ -5,8 +5,9 @@ import (
 )
 
 // Mutate transforms a dataframe by adding new columns or changing new ones.
-// This function accepts Column, []Column, vector.Vector, []vector.Vector, Option and []Option.
-// Vectors must have a name.
+// This function accepts Column, []Column, vector.Vector, []vector.Vector, AcrossColumns (see Across), Option
+// and []Option.
+// Vectors must have a name. A grouped dataframe stays grouped by the same columns.
 // Possible options are:
 //   - OptionAfterColumn("name")
 //   - OptionBeforeColumn("name")
@@ -30,6 +31,8 @@ func (df *Dataframe) Mutate(arguments ...any) *Dataframe {
 					columns = append(columns, Column{v.Name(), v})
 				}
 			}
+		case AcrossColumns:
+			columns = append(columns, df.expandAcross(val)...)
 		case Option:
 			options = append(options, val)
 		case []Option:
@@ -78,5 +81,10 @@ func (df *Dataframe) Mutate(arguments ...any) *Dataframe {
 		newColumns = append(newColumns, columnMap[name])
 	}
 
-	return New(newColumns, OptionColumnNames(newNames))
+	newDf := New(newColumns, OptionColumnNames(newNames))
+	if df.IsGrouped() {
+		return newDf.GroupBy(df.groupedBy)
+	}
+
+	return newDf
 }
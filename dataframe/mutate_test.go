@@ -155,3 +155,72 @@ func TestDataframe_Mutate(t *testing.T) {
 		})
 	}
 }
+
+func TestDataframe_MutateGrouped(t *testing.T) {
+	df := New([]Column{
+		{"dep", vector.String([]string{"A", "B", "A", "B", "A"})},
+		{"salary", vector.Integer([]int{100, 200, 300, 150, 200})},
+	})
+	groupedDf := df.GroupBy("dep")
+
+	newDf := groupedDf.Mutate(
+		groupedDf.Cn("salary").Lag(1),
+		groupedDf.Cn("salary").MinRank(),
+	)
+
+	expectedColumns := []vector.Vector{
+		vector.String([]string{"A", "B", "A", "B", "A"}),
+		vector.Integer([]int{100, 200, 300, 150, 200}),
+		vector.IntegerWithNA([]int{0, 0, 100, 200, 300}, []bool{true, true, false, false, false}),
+		vector.Integer([]int{1, 2, 3, 1, 2}),
+	}
+	expectedNames := []string{"dep", "salary", "salary_lag", "salary_min_rank"}
+
+	if !vector.CompareVectorArrs(newDf.columns, expectedColumns) {
+		t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, expectedColumns))
+	}
+
+	if !reflect.DeepEqual(newDf.columnNames, expectedNames) {
+		t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)", newDf.columnNames, expectedNames))
+	}
+
+	if !reflect.DeepEqual(newDf.GroupedBy(), []string{"dep"}) {
+		t.Error(fmt.Sprintf("Grouped by (%v) is not equal to expected (%v)", newDf.GroupedBy(), []string{"dep"}))
+	}
+}
+
+func TestDataframe_MutateGroupedDerived(t *testing.T) {
+	df := New([]Column{
+		{"dep", vector.String([]string{"A", "B", "A", "B", "A"})},
+		{"salary", vector.IntegerWithNA([]int{100, 200, 300, 0, 200}, []bool{false, false, false, true, false})},
+	})
+	groupedDf := df.GroupBy("dep")
+	salary := groupedDf.Cn("salary")
+
+	newDf := groupedDf.Mutate(
+		Column{"level", vector.IfElse(vector.Greater(salary, vector.Integer([]int{150})),
+			vector.String([]string{"high"}), vector.String([]string{"low"}))},
+		Column{"bonus", vector.CaseWhen(
+			vector.When{Cond: vector.Boolean(salary.Gte(300)), Value: vector.Float([]float64{0.5})},
+			vector.When{Cond: vector.Boolean(salary.Gte(200)), Value: vector.Integer([]int{1})},
+			vector.When{Value: vector.Integer([]int{0})},
+		)},
+		Column{"dep_name", groupedDf.Cn("dep").Recode(map[any]any{"A": "Alpha"}, "Other")},
+	)
+
+	expectedColumns := []vector.Vector{
+		vector.String([]string{"A", "B", "A", "B", "A"}),
+		vector.IntegerWithNA([]int{100, 200, 300, 0, 200}, []bool{false, false, false, true, false}),
+		vector.StringWithNA([]string{"low", "high", "high", "", "high"}, []bool{false, false, false, true, false}),
+		vector.Float([]float64{0, 1, 0.5, 0, 1}),
+		vector.String([]string{"Alpha", "Other", "Alpha", "Other", "Alpha"}),
+	}
+
+	if !vector.CompareVectorArrs(newDf.columns, expectedColumns) {
+		t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, expectedColumns))
+	}
+
+	if !reflect.DeepEqual(newDf.GroupedBy(), []string{"dep"}) {
+		t.Error(fmt.Sprintf("Grouped by (%v) is not equal to expected (%v)", newDf.GroupedBy(), []string{"dep"}))
+	}
+}
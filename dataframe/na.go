@@ -0,0 +1,88 @@
+package dataframe
+
+import (
+	"logarithmotechnia/vector"
+)
+
+const (
+	FillDown = "down"
+	FillUp   = "up"
+)
+
+// Fill replaces NA-values in selected columns by the previous (FillDown) or the next (FillUp) non-NA values.
+// If the dataframe is grouped, values are filled within groups and the dataframe stays grouped.
+//
+// Acceptable selectors are the same as for Select(). If no selectors are provided, all columns are filled.
+// The dataframe is returned unchanged if the direction is unknown.
+func (df *Dataframe) Fill(direction string, selectors ...any) *Dataframe {
+	if direction != FillDown && direction != FillUp {
+		return df
+	}
+
+	return df.mutateSelected(selectors, func(column vector.Vector) vector.Vector {
+		if direction == FillUp {
+			return column.FillUp()
+		}
+
+		return column.FillDown()
+	})
+}
+
+// DropNA returns a dataframe without rows which have NA-values in any of selected columns. A grouped dataframe
+// stays grouped by the same columns.
+//
+// Acceptable selectors are the same as for Select(). If no selectors are provided, all columns are checked.
+func (df *Dataframe) DropNA(selectors ...any) *Dataframe {
+	selected := df.columns
+	if len(selectors) > 0 {
+		selected = df.Select(selectors...).columns
+	}
+
+	keep := vector.Not(make([]bool, df.rowNum))
+	for _, column := range selected {
+		keep = vector.And(keep, vector.Not(column.IsNA()))
+	}
+
+	newDf := df.Filter(keep)
+	if df.IsGrouped() {
+		return newDf.GroupBy(df.groupedBy)
+	}
+
+	return newDf
+}
+
+// ReplaceNA replaces NA-values in columns by values from the map, where keys are column names. Values are
+// converted to types of the columns. Keys, which are not column names, are ignored. A grouped dataframe stays
+// grouped by the same columns.
+func (df *Dataframe) ReplaceNA(values map[string]any) *Dataframe {
+	names := []string{}
+	for _, name := range df.columnNames {
+		if _, ok := values[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return df
+	}
+
+	return df.mutateSelected([]any{names}, func(column vector.Vector) vector.Vector {
+		return column.ReplaceNA(values[column.Name()])
+	})
+}
+
+// mutateSelected replaces selected columns (all columns if there are no selectors) by results of the function.
+// Vectors passed to the function are named after the columns.
+func (df *Dataframe) mutateSelected(selectors []any, fn func(vector.Vector) vector.Vector) *Dataframe {
+	names := df.columnNames
+	if len(selectors) > 0 {
+		names = df.Select(selectors...).columnNames
+	}
+
+	columns := []Column{}
+	for _, name := range names {
+		columns = append(columns, Column{name, fn(df.Cn(name))})
+	}
+
+	return df.Mutate(columns)
+}
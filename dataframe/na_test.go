@@ -0,0 +1,92 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_NA(t *testing.T) {
+	df := New([]Column{
+		{"sensor", vector.String([]string{"a", "b", "a", "b", "a"})},
+		{"value", vector.FloatWithNA([]float64{1, 0, 0, 4, 5}, []bool{false, true, true, false, false})},
+		{"state", vector.StringWithNA([]string{"on", "off", "", "", "on"}, []bool{false, false, true, true, false})},
+	})
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		columns []vector.Vector
+	}{
+		{
+			name: "fill down",
+			df:   df.Fill(FillDown),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b", "a", "b", "a"}),
+				vector.FloatWithNA([]float64{1, 1, 1, 4, 5}, nil),
+				vector.String([]string{"on", "off", "off", "off", "on"}),
+			},
+		},
+		{
+			name: "fill up selected",
+			df:   df.Fill(FillUp, "state"),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b", "a", "b", "a"}),
+				vector.FloatWithNA([]float64{1, 0, 0, 4, 5}, []bool{false, true, true, false, false}),
+				vector.String([]string{"on", "off", "on", "on", "on"}),
+			},
+		},
+		{
+			name: "fill down grouped",
+			df:   df.GroupBy("sensor").Fill(FillDown, "value"),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b", "a", "b", "a"}),
+				vector.FloatWithNA([]float64{1, 0, 1, 4, 5}, []bool{false, true, false, false, false}),
+				vector.StringWithNA([]string{"on", "off", "", "", "on"}, []bool{false, false, true, true, false}),
+			},
+		},
+		{
+			name: "drop NA",
+			df:   df.DropNA(),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "a"}),
+				vector.Float([]float64{1, 5}),
+				vector.String([]string{"on", "on"}),
+			},
+		},
+		{
+			name: "drop NA selected",
+			df:   df.DropNA("value"),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b", "a"}),
+				vector.Float([]float64{1, 4, 5}),
+				vector.StringWithNA([]string{"on", "", "on"}, []bool{false, true, false}),
+			},
+		},
+		{
+			name: "replace NA",
+			df:   df.ReplaceNA(map[string]any{"value": 0.0, "state": "unknown", "missing": 1}),
+			columns: []vector.Vector{
+				vector.String([]string{"a", "b", "a", "b", "a"}),
+				vector.Float([]float64{1, 0, 0, 4, 5}),
+				vector.String([]string{"on", "off", "unknown", "unknown", "on"}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !vector.CompareVectorArrs(data.df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", data.df.columns, data.columns))
+			}
+			if !reflect.DeepEqual(data.df.columnNames, []string{"sensor", "value", "state"}) {
+				t.Error(fmt.Sprintf("Column names (%v) are not correct", data.df.columnNames))
+			}
+		})
+	}
+
+	if !df.GroupBy("sensor").DropNA().IsGrouped() {
+		t.Error("Grouped dataframe became ungrouped after DropNA")
+	}
+}
@@ -0,0 +1,209 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"logarithmotechnia/vector"
+	"time"
+)
+
+// PivotLonger transforms the dataframe to a longer form. Selected columns are collapsed into two columns: the first
+// one (namesTo) contains names of the collapsed columns, the second one (valuesTo) - their values. All other columns
+// are repeated for every collapsed column.
+//
+// Acceptable selectors are the same as for Select(). Values of the collapsed columns are converted to the type of
+// the first of them.
+func (df *Dataframe) PivotLonger(cols any, namesTo, valuesTo string) *Dataframe {
+	pivotColumns := df.Select(cols).columnNames
+	if len(pivotColumns) == 0 {
+		return df
+	}
+
+	idColumns := []string{}
+	for _, name := range df.columnNames {
+		if strPosInSlice(pivotColumns, name) == -1 {
+			idColumns = append(idColumns, name)
+		}
+	}
+
+	pivotNum := len(pivotColumns)
+	rowNum := df.rowNum * pivotNum
+
+	idIndices := make([]int, rowNum)
+	valueIndices := make([]int, rowNum)
+	names := make([]string, rowNum)
+	for row := 0; row < df.rowNum; row++ {
+		for i, name := range pivotColumns {
+			pos := row*pivotNum + i
+			idIndices[pos] = row + 1
+			valueIndices[pos] = i*df.rowNum + row + 1
+			names[pos] = name
+		}
+	}
+
+	pivotVectors := make([]vector.Vector, pivotNum)
+	for i, name := range pivotColumns {
+		pivotVectors[i] = df.Cn(name)
+	}
+
+	columns := []vector.Vector{}
+	for _, name := range idColumns {
+		columns = append(columns, df.Cn(name).ByIndices(idIndices))
+	}
+	columns = append(columns,
+		vector.String(names),
+		vector.Combine(pivotVectors...).ByIndices(valueIndices),
+	)
+
+	columnNames := append(idColumns, namesTo, valuesTo)
+
+	return New(columns, OptionColumnNames(columnNames))
+}
+
+// PivotWider transforms the dataframe to a wider form. Unique values of namesFrom column become names of new
+// columns and the values of these columns are taken from valuesFrom column. Every unique combination of the rest
+// columns forms a row of the resulting dataframe.
+//
+// Missing combinations are filled with valuesFill or with NA of the same type as valuesFrom if valuesFill is nil.
+// If there are several values for the same combination, they are passed to aggregator (for example, a function
+// calling Sum() of the vector). If aggregator is nil, an error is returned. If aggregator is provided, it is applied to all
+// combinations and has to return a vector of length 1.
+func (df *Dataframe) PivotWider(
+	namesFrom, valuesFrom string,
+	valuesFill any,
+	aggregator func(vector.Vector) vector.Vector,
+) (*Dataframe, error) {
+	if !df.HasColumn(namesFrom) {
+		return nil, errors.New(fmt.Sprintf("column %s does not exist", namesFrom))
+	}
+
+	if !df.HasColumn(valuesFrom) {
+		return nil, errors.New(fmt.Sprintf("column %s does not exist", valuesFrom))
+	}
+
+	idColumns := []string{}
+	for _, name := range df.columnNames {
+		if name != namesFrom && name != valuesFrom {
+			idColumns = append(idColumns, name)
+		}
+	}
+
+	rowGroups := df.rowGroups(idColumns)
+	nameGroups := df.rowGroups([]string{namesFrom})
+
+	newNames := make([]string, len(nameGroups))
+	nameIndex := make([]int, df.rowNum)
+	namesVec, namesNA := df.Cn(namesFrom).Strings()
+	for i, group := range nameGroups {
+		if namesNA[group[0]-1] {
+			newNames[i] = "NA"
+		} else {
+			newNames[i] = namesVec[group[0]-1]
+		}
+
+		for _, idx := range group {
+			nameIndex[idx-1] = i
+		}
+	}
+
+	cells := make([][][]int, len(nameGroups))
+	for i := range cells {
+		cells[i] = make([][]int, len(rowGroups))
+	}
+
+	for row, group := range rowGroups {
+		for _, idx := range group {
+			col := nameIndex[idx-1]
+			cells[col][row] = append(cells[col][row], idx)
+		}
+	}
+
+	source := df.Cn(valuesFrom)
+	cellIndices := make([][]int, len(nameGroups))
+	aggregated := []vector.Vector{}
+
+	for col := range cells {
+		cellIndices[col] = make([]int, len(rowGroups))
+
+		for row, indices := range cells[col] {
+			if len(indices) == 0 {
+				continue
+			}
+
+			if aggregator == nil {
+				if len(indices) > 1 {
+					return nil, errors.New(fmt.Sprintf("duplicate values for column %s in row %d",
+						newNames[col], row+1))
+				}
+
+				cellIndices[col][row] = indices[0]
+				continue
+			}
+
+			value := aggregator(source.ByIndices(indices))
+			if value == nil || value.Len() != 1 {
+				return nil, errors.New(fmt.Sprintf("aggregator returned not a single value for column %s in row %d",
+					newNames[col], row+1))
+			}
+
+			aggregated = append(aggregated, value)
+			cellIndices[col][row] = len(aggregated)
+		}
+	}
+
+	if aggregator != nil {
+		source = vector.Combine(aggregated...)
+		if source == nil {
+			source = vector.NA(0)
+		}
+	}
+
+	if valuesFill != nil {
+		source = source.Append(valueToVector(valuesFill))
+		for col := range cellIndices {
+			for row := range cellIndices[col] {
+				if cellIndices[col][row] == 0 {
+					cellIndices[col][row] = source.Len()
+				}
+			}
+		}
+	}
+
+	firstIndices := make([]int, len(rowGroups))
+	for i, group := range rowGroups {
+		firstIndices[i] = group[0]
+	}
+
+	columns := []vector.Vector{}
+	for _, name := range idColumns {
+		columns = append(columns, df.Cn(name).ByIndices(firstIndices))
+	}
+	for col := range cellIndices {
+		columns = append(columns, source.ByIndices(cellIndices[col]))
+	}
+
+	columnNames := append(idColumns, newNames...)
+
+	return New(columns, OptionColumnNames(columnNames)), nil
+}
+
+func valueToVector(val any) vector.Vector {
+	switch v := val.(type) {
+	case int:
+		return vector.Integer([]int{v})
+	case float64:
+		return vector.Float([]float64{v})
+	case string:
+		return vector.String([]string{v})
+	case bool:
+		return vector.Boolean([]bool{v})
+	case complex128:
+		return vector.Complex([]complex128{v})
+	case time.Time:
+		return vector.Time([]time.Time{v})
+	case vector.Vector:
+		return v
+	}
+
+	return vector.Any([]any{val})
+}
@@ -0,0 +1,189 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_PivotLonger(t *testing.T) {
+	df := New([]Column{
+		{"country", vector.String([]string{"A", "B"})},
+		{"1999", vector.Integer([]int{10, 20})},
+		{"2000", vector.IntegerWithNA([]int{11, 0}, []bool{false, true})},
+	})
+
+	testData := []struct {
+		name        string
+		cols        any
+		columns     []vector.Vector
+		columnNames []string
+	}{
+		{
+			name: "by names",
+			cols: []string{"1999", "2000"},
+			columns: []vector.Vector{
+				vector.String([]string{"A", "A", "B", "B"}),
+				vector.String([]string{"1999", "2000", "1999", "2000"}),
+				vector.IntegerWithNA([]int{10, 11, 20, 0}, []bool{false, false, false, true}),
+			},
+			columnNames: []string{"country", "year", "cases"},
+		},
+		{
+			name: "by removal",
+			cols: "-country",
+			columns: []vector.Vector{
+				vector.String([]string{"A", "A", "B", "B"}),
+				vector.String([]string{"1999", "2000", "1999", "2000"}),
+				vector.IntegerWithNA([]int{10, 11, 20, 0}, []bool{false, false, false, true}),
+			},
+			columnNames: []string{"country", "year", "cases"},
+		},
+		{
+			name: "one column",
+			cols: 2,
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B"}),
+				vector.IntegerWithNA([]int{11, 0}, []bool{false, true}),
+				vector.String([]string{"1999", "1999"}),
+				vector.Integer([]int{10, 20}),
+			},
+			columnNames: []string{"country", "2000", "year", "cases"},
+		},
+		{
+			name: "no columns",
+			cols: "D",
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B"}),
+				vector.Integer([]int{10, 20}),
+				vector.IntegerWithNA([]int{11, 0}, []bool{false, true}),
+			},
+			columnNames: []string{"country", "1999", "2000"},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			newDf := df.PivotLonger(data.cols, "year", "cases")
+
+			if !vector.CompareVectorArrs(newDf.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, data.columns))
+			}
+
+			if !reflect.DeepEqual(newDf.columnNames, data.columnNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					newDf.columnNames, data.columnNames))
+			}
+		})
+	}
+}
+
+func TestDataframe_PivotWider(t *testing.T) {
+	df := New([]Column{
+		{"country", vector.String([]string{"A", "A", "B", "C", "C"})},
+		{"year", vector.Integer([]int{1999, 2000, 1999, 2000, 2000})},
+		{"cases", vector.Integer([]int{10, 11, 20, 30, 31})},
+	})
+
+	testData := []struct {
+		name        string
+		df          *Dataframe
+		fill        any
+		aggregator  func(vector.Vector) vector.Vector
+		columns     []vector.Vector
+		columnNames []string
+		isErr       bool
+	}{
+		{
+			name:       "sum",
+			df:         df,
+			aggregator: func(vec vector.Vector) vector.Vector { return vec.Sum() },
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B", "C"}),
+				vector.IntegerWithNA([]int{10, 20, 0}, []bool{false, false, true}),
+				vector.IntegerWithNA([]int{11, 0, 61}, []bool{false, true, false}),
+			},
+			columnNames: []string{"country", "1999", "2000"},
+		},
+		{
+			name:       "mean with fill",
+			df:         df,
+			fill:       0.0,
+			aggregator: func(vec vector.Vector) vector.Vector { return vec.Mean() },
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B", "C"}),
+				vector.Float([]float64{10, 20, 0}),
+				vector.Float([]float64{11, 0, 30.5}),
+			},
+			columnNames: []string{"country", "1999", "2000"},
+		},
+		{
+			name: "no duplicates",
+			df:   df.ByIndices([]int{1, 2, 3, 4}),
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B", "C"}),
+				vector.IntegerWithNA([]int{10, 20, 0}, []bool{false, false, true}),
+				vector.IntegerWithNA([]int{11, 0, 30}, []bool{false, true, false}),
+			},
+			columnNames: []string{"country", "1999", "2000"},
+		},
+		{
+			name: "fill",
+			df:   df.ByIndices([]int{1, 2, 3, 4}),
+			fill: -1,
+			columns: []vector.Vector{
+				vector.String([]string{"A", "B", "C"}),
+				vector.Integer([]int{10, 20, -1}),
+				vector.Integer([]int{11, -1, 30}),
+			},
+			columnNames: []string{"country", "1999", "2000"},
+		},
+		{
+			name: "no id columns",
+			df:   df.Select("year", "cases").ByIndices([]int{1, 2}),
+			columns: []vector.Vector{
+				vector.Integer([]int{10}),
+				vector.Integer([]int{11}),
+			},
+			columnNames: []string{"1999", "2000"},
+		},
+		{
+			name:  "duplicates",
+			df:    df,
+			isErr: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			newDf, err := data.df.PivotWider("year", "cases", data.fill, data.aggregator)
+
+			if data.isErr {
+				if err == nil {
+					t.Error("Error is expected")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(newDf.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, data.columns))
+			}
+
+			if !reflect.DeepEqual(newDf.columnNames, data.columnNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					newDf.columnNames, data.columnNames))
+			}
+		})
+	}
+
+	_, err := df.PivotWider("D", "cases", nil, nil)
+	if err == nil {
+		t.Error("Error is expected for a non-existent column")
+	}
+}
@@ -0,0 +1,88 @@
+package dataframe
+
+import (
+	"logarithmotechnia/vector"
+	"sort"
+)
+
+// Union returns unique rows of both dataframes (like UNION in SQL) in the order of their first appearance.
+// Columns of the other dataframe are matched by names and converted to types of the dataframe columns in the same
+// way as BindRows does. Missing columns are filled with NA and extra ones are ignored. NA-values are considered
+// equal to each other. The result is not grouped.
+func (df *Dataframe) Union(other *Dataframe) *Dataframe {
+	return df.setOperation(other, func(inDf, inOther bool) bool {
+		return true
+	})
+}
+
+// Intersect returns unique rows of the dataframe which are present in the other dataframe (like INTERSECT in SQL).
+// Columns are matched in the same way as in Union.
+func (df *Dataframe) Intersect(other *Dataframe) *Dataframe {
+	return df.setOperation(other, func(inDf, inOther bool) bool {
+		return inDf && inOther
+	})
+}
+
+// Except returns unique rows of the dataframe which are not present in the other dataframe (like EXCEPT in SQL).
+// Columns are matched in the same way as in Union.
+func (df *Dataframe) Except(other *Dataframe) *Dataframe {
+	return df.setOperation(other, func(inDf, inOther bool) bool {
+		return inDf && !inOther
+	})
+}
+
+func (df *Dataframe) setOperation(other *Dataframe, selector func(inDf, inOther bool) bool) *Dataframe {
+	combined := df.BindRows(other)
+	if combined.rowNum == 0 {
+		return combined
+	}
+
+	indices := []int{}
+	for _, group := range combined.rowKeyGroups() {
+		inDf := group[0] <= df.rowNum
+		inOther := group[len(group)-1] > df.rowNum
+		if selector(inDf, inOther) {
+			indices = append(indices, group[0])
+		}
+	}
+	sort.Ints(indices)
+
+	return combined.ByIndices(indices)
+}
+
+// rowKeyGroups returns groups of equal rows where values are compared by keys of vector.ElementKeys(), so rows
+// are equal in the same way as elements in set operations of vectors.
+func (df *Dataframe) rowKeyGroups() [][]int {
+	type rowKey struct {
+		group int
+		key   any
+	}
+
+	rowGroups := make([]int, df.rowNum)
+	for _, column := range df.columns {
+		ids := map[rowKey]int{}
+		for i, key := range vector.ElementKeys(column) {
+			k := rowKey{rowGroups[i], key}
+			id, ok := ids[k]
+			if !ok {
+				id = len(ids)
+				ids[k] = id
+			}
+			rowGroups[i] = id
+		}
+	}
+
+	groups := [][]int{}
+	positions := map[int]int{}
+	for i, id := range rowGroups {
+		pos, ok := positions[id]
+		if !ok {
+			pos = len(groups)
+			positions[id] = pos
+			groups = append(groups, []int{})
+		}
+		groups[pos] = append(groups[pos], i+1)
+	}
+
+	return groups
+}
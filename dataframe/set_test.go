@@ -0,0 +1,87 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"math"
+	"testing"
+)
+
+func TestDataframe_SetOperations(t *testing.T) {
+	df := New([]Column{
+		{"id", vector.Integer([]int{1, 2, 2, 3})},
+		{"name", vector.StringWithNA([]string{"a", "b", "b", ""}, []bool{false, false, false, true})},
+	})
+	other := New([]Column{
+		{"name", vector.StringWithNA([]string{"b", "", "d"}, []bool{false, true, false})},
+		{"id", vector.Integer([]int{2, 3, 4})},
+		{"extra", vector.Integer([]int{0, 0, 0})},
+	})
+	anyDf := New([]Column{
+		{"value", vector.Any([]any{[]int{1}, []int{2}, []int{1}})},
+		{"ratio", vector.Float([]float64{math.NaN(), 0.5, math.NaN()})},
+	})
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		columns []vector.Vector
+	}{
+		{
+			name: "union",
+			df:   df.Union(other),
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3, 4}),
+				vector.StringWithNA([]string{"a", "b", "", "d"}, []bool{false, false, true, false}),
+			},
+		},
+		{
+			name: "intersect",
+			df:   df.Intersect(other),
+			columns: []vector.Vector{
+				vector.Integer([]int{2, 3}),
+				vector.StringWithNA([]string{"b", ""}, []bool{false, true}),
+			},
+		},
+		{
+			name: "except",
+			df:   df.Except(other),
+			columns: []vector.Vector{
+				vector.Integer([]int{1}),
+				vector.String([]string{"a"}),
+			},
+		},
+		{
+			name: "except missing column",
+			df:   other.Select("extra", "id").Except(df),
+			columns: []vector.Vector{
+				vector.Integer([]int{0, 0, 0}),
+				vector.Integer([]int{2, 3, 4}),
+			},
+		},
+		{
+			name: "except with any and NaN columns",
+			df:   anyDf.Except(anyDf.FromTo(1, 1)),
+			columns: []vector.Vector{
+				vector.Any([]any{[]int{2}}),
+				vector.Float([]float64{0.5}),
+			},
+		},
+		{
+			name: "union with any and NaN columns",
+			df:   anyDf.Union(anyDf),
+			columns: []vector.Vector{
+				vector.Any([]any{[]int{1}, []int{2}}),
+				vector.Float([]float64{math.NaN(), 0.5}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !vector.CompareVectorArrs(data.df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", data.df.columns, data.columns))
+			}
+		})
+	}
+}
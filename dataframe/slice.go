@@ -0,0 +1,350 @@
+package dataframe
+
+import (
+	"container/heap"
+	"logarithmotechnia/vector"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Head returns the first n rows of the dataframe or of every group if the dataframe is grouped. Rows of the
+// resulting dataframe are ordered by groups and it stays grouped by the same columns.
+func (df *Dataframe) Head(n int) *Dataframe {
+	if n < 0 {
+		n = 0
+	}
+
+	return df.sliceGroups(func(group []int) []int {
+		if n > len(group) {
+			return group
+		}
+
+		return group[:n]
+	})
+}
+
+// Tail returns the last n rows of the dataframe or of every group in the same way as Head does.
+func (df *Dataframe) Tail(n int) *Dataframe {
+	if n < 0 {
+		n = 0
+	}
+
+	return df.sliceGroups(func(group []int) []int {
+		if n > len(group) {
+			return group
+		}
+
+		return group[len(group)-n:]
+	})
+}
+
+// SliceSample returns randomly selected rows of the dataframe or of every group in the same way as Head does.
+// The size is either a number of rows (int) or a proportion of rows (float64) which is rounded down. Without
+// replacement the number of rows can't exceed the size of the group.
+//
+// Possible options are:
+//   - OptionSampleReplace(true) - sample with replacement.
+//   - OptionSampleWeight("column") - probabilities of rows are proportional to values of the numeric column.
+//     NA and negative weights are considered to be zero.
+//   - OptionSampleSeed(seed) - seed of the random generator for reproducible samples.
+func (df *Dataframe) SliceSample(size any, options ...Option) *Dataframe {
+	conf := MergeOptions(options)
+
+	replace := conf.HasOption(KeyOptionSampleReplace) && conf.Value(KeyOptionSampleReplace).(bool)
+
+	var weights []float64
+	if conf.HasOption(KeyOptionSampleWeight) && df.HasColumn(conf.Value(KeyOptionSampleWeight).(string)) {
+		var weightsNA []bool
+		weights, weightsNA = df.Cn(conf.Value(KeyOptionSampleWeight).(string)).Floats()
+		for i, weight := range weights {
+			if weightsNA[i] || math.IsNaN(weight) || weight < 0 {
+				weights[i] = 0
+			}
+		}
+	}
+
+	var source rand.Source
+	if conf.HasOption(KeyOptionSampleSeed) {
+		source = rand.NewSource(conf.Value(KeyOptionSampleSeed).(int64))
+	} else {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+	random := rand.New(source)
+
+	return df.sliceGroups(func(group []int) []int {
+		n := 0
+		switch val := size.(type) {
+		case int:
+			n = val
+		case float64:
+			n = int(math.Floor(val * float64(len(group))))
+		}
+		if n < 0 {
+			n = 0
+		}
+		if !replace && n > len(group) {
+			n = len(group)
+		}
+
+		return sampleIndices(group, n, replace, weights, random)
+	})
+}
+
+// SliceMin returns n rows with the smallest values of the column for the dataframe or for every group in the same
+// way as Head does. Rows are ordered by values of the column and NA-values are never selected. If withTies is true,
+// all rows with the value equal to the n-th one are also returned. If the column is missing or its values can't be
+// ordered, no rows are returned.
+func (df *Dataframe) SliceMin(column string, n int, withTies bool) *Dataframe {
+	return df.sliceExtremes(column, n, withTies, false)
+}
+
+// SliceMax returns n rows with the largest values of the column in the same way as SliceMin does.
+func (df *Dataframe) SliceMax(column string, n int, withTies bool) *Dataframe {
+	return df.sliceExtremes(column, n, withTies, true)
+}
+
+func (df *Dataframe) sliceExtremes(column string, n int, withTies bool, largest bool) *Dataframe {
+	if !df.HasColumn(column) {
+		return df.Head(0)
+	}
+
+	less, na, ok := columnLess(df.Cn(column))
+	if !ok {
+		return df.Head(0)
+	}
+	if largest {
+		smaller := less
+		less = func(a, b int) bool {
+			return smaller(b, a)
+		}
+	}
+
+	return df.sliceGroups(func(group []int) []int {
+		valid := make([]int, 0, len(group))
+		for _, idx := range group {
+			if !na[idx-1] {
+				valid = append(valid, idx)
+			}
+		}
+
+		return smallestIndices(valid, n, withTies, less)
+	})
+}
+
+// sliceGroups selects rows of every group (or of the whole dataframe if it is not grouped) with the selector.
+func (df *Dataframe) sliceGroups(selector func(group []int) []int) *Dataframe {
+	groups := [][]int(df.groupIndex)
+	if !df.IsGrouped() {
+		groups = [][]int{make([]int, df.rowNum)}
+		for i := range groups[0] {
+			groups[0][i] = i + 1
+		}
+	}
+
+	indices := []int{}
+	for _, group := range groups {
+		indices = append(indices, selector(group)...)
+	}
+
+	newDf := df.ByIndices(indices)
+	if df.IsGrouped() {
+		return newDf.GroupBy(df.groupedBy)
+	}
+
+	return newDf
+}
+
+// sampleIndices randomly selects n indices. Weights (if not nil) are weights of rows which indices point to.
+func sampleIndices(indices []int, n int, replace bool, weights []float64, random *rand.Rand) []int {
+	sample := make([]int, 0, n)
+	if n == 0 || len(indices) == 0 {
+		return sample
+	}
+
+	if weights == nil {
+		if replace {
+			for i := 0; i < n; i++ {
+				sample = append(sample, indices[random.Intn(len(indices))])
+			}
+
+			return sample
+		}
+
+		for _, pos := range random.Perm(len(indices))[:n] {
+			sample = append(sample, indices[pos])
+		}
+
+		return sample
+	}
+
+	cumulative := make([]float64, len(indices))
+	total := 0.0
+	for i, idx := range indices {
+		total += weights[idx-1]
+		cumulative[i] = total
+	}
+
+	if replace {
+		if total == 0 {
+			return sample
+		}
+
+		for i := 0; i < n; i++ {
+			point := random.Float64() * total
+			sample = append(sample, indices[sort.SearchFloat64s(cumulative, math.Nextafter(point, math.Inf(1)))])
+		}
+
+		return sample
+	}
+
+	remaining := append([]int{}, indices...)
+	for i := 0; i < n; i++ {
+		total = 0
+		for _, idx := range remaining {
+			total += weights[idx-1]
+		}
+		if total == 0 {
+			break
+		}
+
+		point := random.Float64() * total
+		pos := 0
+		for acc := 0.0; pos < len(remaining)-1; pos++ {
+			acc += weights[remaining[pos]-1]
+			if point < acc {
+				break
+			}
+		}
+
+		sample = append(sample, remaining[pos])
+		remaining = append(remaining[:pos], remaining[pos+1:]...)
+	}
+
+	return sample
+}
+
+// columnLess returns a function which compares values of the column in rows with the indices and NA-flags of
+// the values. Values of other arrangeable types are compared by their ranks. False is returned if the values
+// can't be ordered.
+func columnLess(column vector.Vector) (func(a, b int) bool, []bool, bool) {
+	switch column.Type() {
+	case vector.PayloadTypeInteger, vector.PayloadTypeInt32, vector.PayloadTypeInt64, vector.PayloadTypeUint8,
+		vector.PayloadTypeBoolean:
+		data, na := column.Integers()
+		return func(a, b int) bool { return data[a-1] < data[b-1] }, na, true
+	case vector.PayloadTypeFloat, vector.PayloadTypeFloat32, vector.PayloadTypeDecimal:
+		data, na := column.Floats()
+		for i, val := range data {
+			na[i] = na[i] || math.IsNaN(val)
+		}
+		return func(a, b int) bool { return data[a-1] < data[b-1] }, na, true
+	case vector.PayloadTypeString:
+		data, na := column.Strings()
+		return func(a, b int) bool { return data[a-1] < data[b-1] }, na, true
+	case vector.PayloadTypeTime, vector.PayloadTypeDate:
+		data, na := column.Times()
+		return func(a, b int) bool { return data[a-1].Before(data[b-1]) }, na, true
+	case vector.PayloadTypeDuration:
+		data, na := column.Durations()
+		return func(a, b int) bool { return data[a-1] < data[b-1] }, na, true
+	}
+
+	if _, ok := column.Payload().(vector.Arrangeable); !ok {
+		return nil, nil, false
+	}
+
+	indices, sortedRanks := column.SortedIndicesWithRanks()
+	ranks := make([]int, column.Len())
+	for i, idx := range indices {
+		ranks[idx-1] = sortedRanks[i]
+	}
+
+	return func(a, b int) bool { return ranks[a-1] < ranks[b-1] }, column.IsNA(), true
+}
+
+// smallestIndices returns n indices with the smallest values ordered by values. It keeps a heap of the n smallest
+// indices seen so far, so the whole slice is never sorted. Ties are resolved by the order of indices. If withTies is
+// true, indices with values equal to the largest selected one are also returned.
+func smallestIndices(indices []int, n int, withTies bool, less func(a, b int) bool) []int {
+	if n <= 0 || len(indices) == 0 {
+		return []int{}
+	}
+
+	selected := &indexHeap{less: func(a, b int) bool {
+		if less(a, b) {
+			return false
+		}
+		if less(b, a) {
+			return true
+		}
+
+		return a > b
+	}}
+	for _, idx := range indices {
+		if selected.Len() < n {
+			heap.Push(selected, idx)
+		} else if less(idx, selected.indices[0]) {
+			selected.indices[0] = idx
+			heap.Fix(selected, 0)
+		}
+	}
+
+	result := selected.indices
+	sort.Slice(result, func(i, j int) bool {
+		if less(result[i], result[j]) {
+			return true
+		}
+		if less(result[j], result[i]) {
+			return false
+		}
+
+		return result[i] < result[j]
+	})
+
+	if withTies {
+		boundary := result[len(result)-1]
+		isSelected := make(map[int]bool, len(result))
+		for _, idx := range result {
+			isSelected[idx] = true
+		}
+
+		for _, idx := range indices {
+			if !isSelected[idx] && !less(boundary, idx) && !less(idx, boundary) {
+				result = append(result, idx)
+			}
+		}
+	}
+
+	return result
+}
+
+// indexHeap is a heap of indices where the root is the largest index according to the less function.
+type indexHeap struct {
+	indices []int
+	less    func(a, b int) bool
+}
+
+func (h *indexHeap) Len() int {
+	return len(h.indices)
+}
+
+func (h *indexHeap) Less(i, j int) bool {
+	return h.less(h.indices[i], h.indices[j])
+}
+
+func (h *indexHeap) Swap(i, j int) {
+	h.indices[i], h.indices[j] = h.indices[j], h.indices[i]
+}
+
+func (h *indexHeap) Push(x any) {
+	h.indices = append(h.indices, x.(int))
+}
+
+func (h *indexHeap) Pop() any {
+	last := h.indices[len(h.indices)-1]
+	h.indices = h.indices[:len(h.indices)-1]
+
+	return last
+}
@@ -0,0 +1,131 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"testing"
+)
+
+func TestDataframe_Slice(t *testing.T) {
+	df := New([]Column{
+		{"team", vector.String([]string{"a", "b", "a", "b", "a", "a"})},
+		{"score", vector.IntegerWithNA([]int{5, 3, 1, 0, 5, 2}, []bool{false, false, false, true, false, false})},
+		{"id", vector.Integer([]int{1, 2, 3, 4, 5, 6})},
+		{"complex", vector.Complex([]complex128{1, 2i, 3, 4i, 5, 6i})},
+		{"level", vector.Factor([]string{"low", "high", "mid", "high", "low", "mid"})},
+	})
+	grouped := df.GroupBy("team")
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		ids     []int
+		grouped bool
+	}{
+		{name: "head", df: df.Head(2), ids: []int{1, 2}},
+		{name: "head more than rows", df: df.Head(10), ids: []int{1, 2, 3, 4, 5, 6}},
+		{name: "tail", df: df.Tail(2), ids: []int{5, 6}},
+		{name: "grouped head", df: grouped.Head(1), ids: []int{1, 2}, grouped: true},
+		{name: "grouped tail", df: grouped.Tail(2), ids: []int{5, 6, 2, 4}, grouped: true},
+		{name: "slice min", df: df.SliceMin("score", 2, false), ids: []int{3, 6}},
+		{name: "slice max", df: df.SliceMax("score", 1, false), ids: []int{1}},
+		{name: "slice max with ties", df: df.SliceMax("score", 1, true), ids: []int{1, 5}},
+		{name: "grouped slice min", df: grouped.SliceMin("score", 1, false), ids: []int{3, 2}, grouped: true},
+		{name: "slice min of all", df: df.SliceMin("score", 10, false), ids: []int{3, 6, 2, 1, 5}},
+		{name: "slice by missing column", df: df.SliceMin("missing", 1, false), ids: []int{}},
+		{name: "slice by complex column", df: df.SliceMax("complex", 1, false), ids: []int{}},
+		{name: "grouped slice by complex column", df: grouped.SliceMin("complex", 1, false), ids: []int{}},
+		{name: "slice by factor column", df: df.SliceMin("level", 1, true), ids: []int{2, 4}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			ids, _ := data.df.Cn("id").Integers()
+			if !reflect.DeepEqual(ids, data.ids) {
+				t.Error(fmt.Sprintf("Ids (%v) are not equal to expected (%v)", ids, data.ids))
+			}
+			if data.df.IsGrouped() != data.grouped {
+				t.Error(fmt.Sprintf("Grouped (%v) is not equal to expected (%v)", data.df.IsGrouped(), data.grouped))
+			}
+		})
+	}
+}
+
+func TestDataframe_SliceSample(t *testing.T) {
+	df := New([]Column{
+		{"team", vector.String([]string{"a", "b", "a", "b", "a", "b"})},
+		{"weight", vector.Float([]float64{0, 1, 0, 0, 5, 0})},
+		{"id", vector.Integer([]int{1, 2, 3, 4, 5, 6})},
+	})
+
+	testData := []struct {
+		name   string
+		df     *Dataframe
+		length int
+		check  func(ids []int) bool
+	}{
+		{
+			name:   "number of rows",
+			df:     df.SliceSample(4, OptionSampleSeed(1)),
+			length: 4,
+			check: func(ids []int) bool {
+				unique := map[int]bool{}
+				for _, id := range ids {
+					unique[id] = true
+				}
+				return len(unique) == len(ids)
+			},
+		},
+		{
+			name:   "proportion per group",
+			df:     df.GroupBy("team").SliceSample(0.5, OptionSampleSeed(2)),
+			length: 2,
+			check: func(ids []int) bool {
+				return ids[0]%2 == 1 && ids[1]%2 == 0
+			},
+		},
+		{
+			name:   "more than rows",
+			df:     df.SliceSample(10),
+			length: 6,
+			check:  func(ids []int) bool { return true },
+		},
+		{
+			name:   "with replacement and weights",
+			df:     df.SliceSample(10, OptionSampleReplace(true), OptionSampleWeight("weight"), OptionSampleSeed(3)),
+			length: 10,
+			check: func(ids []int) bool {
+				for _, id := range ids {
+					if id != 2 && id != 5 {
+						return false
+					}
+				}
+				return true
+			},
+		},
+		{
+			name:   "weights without replacement",
+			df:     df.SliceSample(3, OptionSampleWeight("weight"), OptionSampleSeed(4)),
+			length: 2,
+			check: func(ids []int) bool {
+				return reflect.DeepEqual(ids, []int{2, 5}) || reflect.DeepEqual(ids, []int{5, 2})
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			ids, _ := data.df.Cn("id").Integers()
+			if len(ids) != data.length || !data.check(ids) {
+				t.Error(fmt.Sprintf("Sample (%v) is not correct", ids))
+			}
+		})
+	}
+
+	first, _ := df.SliceSample(3, OptionSampleSeed(5)).Cn("id").Integers()
+	second, _ := df.SliceSample(3, OptionSampleSeed(5)).Cn("id").Integers()
+	if !reflect.DeepEqual(first, second) {
+		t.Error(fmt.Sprintf("Samples with the same seed (%v and %v) are different", first, second))
+	}
+}
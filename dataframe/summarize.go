@@ -7,6 +7,10 @@ import "logarithmotechnia/vector"
 //
 //	groupedDf := df.GroupBy("Category")
 //	aggregatedDf := groupedDf.Summarize(groupedDf.Cn("Price").Sum(), groupedDf.Cn("Capacity").Sum())
+//
+// Functions can be applied to several columns at once with Across:
+//
+//	aggregatedDf := groupedDf.Summarize(Across([]string{"Price", "Capacity"}, fns, "{col}_{fn}"))
 func (df *Dataframe) Summarize(columns ...any) *Dataframe {
 	if !df.IsGrouped() {
 		return df
@@ -33,6 +37,8 @@ func (df *Dataframe) Summarize(columns ...any) *Dataframe {
 			for _, columnCol := range c {
 				newColumns = append(newColumns, columnCol)
 			}
+		case AcrossColumns:
+			newColumns = append(newColumns, df.expandAcross(c)...)
 		}
 	}
 
@@ -85,6 +85,20 @@ func TestDataframe_Summarize(t *testing.T) {
 			},
 			columnNames: []string{"Column A", "Column B", "D"},
 		},
+		{
+			name:      "statistics with na removed",
+			groupedDf: groupedByD,
+			summarizers: []any{
+				groupedByD.Cn("B").Sum(vector.OptionNARemove(true)),
+				groupedByD.Cn("A").N(),
+			},
+			vecs: []vector.Vector{
+				vector.Integer([]int{100, 40, 0, 280}),
+				vector.Integer([]int{2, 2, 1, 3}),
+				vector.String([]string{"A", "B", "C", "D"}),
+			},
+			columnNames: []string{"B_sum", "A_n", "D"},
+		},
 	}
 
 	for _, data := range testData {
@@ -1,6 +1,7 @@
 package dataframe
 
 import (
+	"bufio"
 	"encoding/csv"
 	"golang.org/x/exp/slices"
 	"io"
@@ -8,11 +9,20 @@ import (
 	"math"
 	"os"
 	"strconv"
+	"strings"
 )
 
 const optionCSVSkipFirstLine = "csvSkipFirstLine"
 const optionCSVSeparator = "csvSeparator"
 const optionCSVDataframeOptions = "csvDataframeOptions"
+const optionCSVSchema = "csvSchema"
+const optionCSVInferRows = "csvInferRows"
+const optionCSVNATokens = "csvNATokens"
+const optionCSVNAString = "csvNAString"
+const optionCSVWriteHeader = "csvWriteHeader"
+const optionCSVQuoteAll = "csvQuoteAll"
+const optionCSVUseCRLF = "csvUseCRLF"
+const optionCSVComment = "csvComment"
 
 type confCSV struct {
 	colTypes      []string
@@ -20,6 +30,23 @@ type confCSV struct {
 	skipFirstLine bool
 	separator     rune
 	dfOptions     []Option
+	schema        map[string]CSVColumnSchema
+	inferRows     int
+	naTokens      []string
+	naString      string
+	writeHeader   bool
+	quoteAll      bool
+	useCRLF       bool
+	comment       rune
+}
+
+// CSVColumnSchema describes how to read a CSV-column. Type is one of "string", "integer", "float", "boolean",
+// "time", "date", "duration" or "factor" (if empty, the type is detected). TimeFormat is a layout for parsing time
+// and date values. Values which are equal to one of NATokens become NA.
+type CSVColumnSchema struct {
+	Type       string
+	TimeFormat string
+	NATokens   []string
 }
 
 // FromCSVFile loads data from a CSV-file to a dataframe.
@@ -28,6 +55,12 @@ type confCSV struct {
 //   - CSVOptionSkipFirstLine(skip bool) - skip first line.if true.
 //   - CSVOptionSeparator(separator rune) - if you need a separator which differs from default one (",").
 //   - CSVOptionDataframeOptions(options ...vector.Option) - options to pass to the new dataframe.
+//   - CSVOptionSchema(schema map[string]CSVColumnSchema) - explicit types, time formats and NA-tokens of columns.
+//   - CSVOptionInferRows(n int) - number of rows to detect column types by (1 by default, 0 means all rows).
+//   - CSVOptionNATokens(tokens ...string) - values which are read as NA (the NA-string, "NA" by default). Use
+//     CSVOptionNATokens(CSVCommonNATokens()...) to read "", "NA", "null" and "N/A" as NA.
+//   - CSVOptionNAString(na string) - the NA-string which is read as NA if NA-tokens are not provided.
+//   - CSVOptionComment(comment rune) - lines beginning with the character are skipped.
 func FromCSVFile(filename string, options ...ConfOption) (df *Dataframe, err error) {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -42,13 +75,11 @@ func FromCSVFile(filename string, options ...ConfOption) (df *Dataframe, err err
 	return df, err
 }
 
+// FromCSV loads data from a CSV-reader to a dataframe. Options are the same as for FromCSVFile().
 func FromCSV(reader io.Reader, options ...ConfOption) (*Dataframe, error) {
 	conf := combineCSVConfig(options...)
 
-	r := csv.NewReader(reader)
-	r.Comma = conf.separator
-
-	records, err := r.ReadAll()
+	records, err := conf.newReader(reader).ReadAll()
 	if err != nil {
 		return nil, err
 	}
@@ -62,36 +93,77 @@ func FromCSV(reader io.Reader, options ...ConfOption) (*Dataframe, error) {
 		return New([]vector.Vector{}), nil
 	}
 
-	conf.colNames = make([]string, colNum)
-	for i := 0; i < colNum; i++ {
-		conf.colNames[i] = strconv.Itoa(i)
-	}
+	conf.colNames = defaultCSVColumnNames(colNum)
 
 	if conf.skipFirstLine {
 		conf.colNames = records[0]
 		records = records[1:]
-		rowNum = rowNum - 1
 	}
 
-	vecs := make([]vector.Vector, colNum)
-	for i := 0; i < colNum; i++ {
-		arr := make([]string, rowNum)
-		for j := 0; j < rowNum; j++ {
-			arr[j] = records[j][i]
+	conf.colTypes = conf.detectColumnTypes(records)
+
+	return conf.recordsToDataframe(records), nil
+}
+
+// CSVChunkReader reads CSV-data by chunks and returns every chunk as a separate dataframe. Column types are
+// determined by the first chunk (and the schema if provided) and are the same for all chunks.
+type CSVChunkReader struct {
+	reader    *csv.Reader
+	conf      confCSV
+	chunkSize int
+	started   bool
+}
+
+// NewCSVChunkReader creates a reader which returns dataframes of chunkSize rows (the last one can be shorter).
+// Options are the same as for FromCSVFile().
+func NewCSVChunkReader(reader io.Reader, chunkSize int, options ...ConfOption) *CSVChunkReader {
+	conf := combineCSVConfig(options...)
+
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+
+	return &CSVChunkReader{
+		reader:    conf.newReader(reader),
+		conf:      conf,
+		chunkSize: chunkSize,
+	}
+}
+
+// Next returns a dataframe with the next chunk of rows. When there is no more data, io.EOF is returned.
+func (cr *CSVChunkReader) Next() (*Dataframe, error) {
+	records := make([][]string, 0, cr.chunkSize)
+
+	for len(records) < cr.chunkSize {
+		record, err := cr.reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !cr.started {
+			cr.started = true
+			cr.conf.colNames = defaultCSVColumnNames(len(record))
+			if cr.conf.skipFirstLine {
+				cr.conf.colNames = record
+				continue
+			}
 		}
-		vecs[i] = vector.String(arr)
+
+		records = append(records, record)
 	}
 
-	types := defaultTypes(colNum)
-	if len(records) > 0 {
-		types = detectTypes(records[0], vector.DefaultStringToBoolConverter())
+	if len(records) == 0 {
+		return nil, io.EOF
 	}
-	vecs = convertVectors(vecs, types)
 
-	dfOptions := append(conf.dfOptions, OptionColumnNames(conf.colNames))
-	df := New(vecs, dfOptions...)
+	if len(cr.conf.colTypes) == 0 {
+		cr.conf.colTypes = cr.conf.detectColumnTypes(records)
+	}
 
-	return df, nil
+	return cr.conf.recordsToDataframe(records), nil
 }
 
 func combineCSVConfig(options ...ConfOption) confCSV {
@@ -101,8 +173,13 @@ func combineCSVConfig(options ...ConfOption) confCSV {
 		skipFirstLine: true,
 		separator:     ',',
 		dfOptions:     []Option{},
+		schema:        map[string]CSVColumnSchema{},
+		inferRows:     1,
+		naString:      "NA",
+		writeHeader:   true,
 	}
 
+	hasNATokens := false
 	for _, option := range options {
 		switch option.Key() {
 		case optionCSVSkipFirstLine:
@@ -111,46 +188,169 @@ func combineCSVConfig(options ...ConfOption) confCSV {
 			conf.separator = option.Value().(rune)
 		case optionCSVDataframeOptions:
 			conf.dfOptions = option.Value().([]Option)
+		case optionCSVSchema:
+			conf.schema = option.Value().(map[string]CSVColumnSchema)
+		case optionCSVInferRows:
+			conf.inferRows = option.Value().(int)
+		case optionCSVNATokens:
+			conf.naTokens = option.Value().([]string)
+			hasNATokens = true
+		case optionCSVNAString:
+			conf.naString = option.Value().(string)
+		case optionCSVWriteHeader:
+			conf.writeHeader = option.Value().(bool)
+		case optionCSVQuoteAll:
+			conf.quoteAll = option.Value().(bool)
+		case optionCSVUseCRLF:
+			conf.useCRLF = option.Value().(bool)
+		case optionCSVComment:
+			conf.comment = option.Value().(rune)
 		}
 	}
 
+	if !hasNATokens {
+		conf.naTokens = []string{conf.naString}
+	}
+
 	return conf
 }
 
-func detectTypes(templateRow []string, boolConv vector.StringToBooleanConverter) []string {
-	types := make([]string, len(templateRow))
-	for i := 0; i < len(templateRow); i++ {
-		types[i] = "string"
-		fVal, err := strconv.ParseFloat(templateRow[i], 64)
-		if err == nil {
-			_, frac := math.Modf(fVal)
-			if frac == 0 {
-				types[i] = "integer"
-			} else {
-				types[i] = "float"
-			}
-			continue
-		}
+// CSVCommonNATokens returns widespread NA-tokens ("", "NA", "null" and "N/A") to be used with CSVOptionNATokens.
+// Empty strings are read as NA with them, so string columns with empty values are not restored after ToCSV.
+func CSVCommonNATokens() []string {
+	return []string{"", "NA", "null", "N/A"}
+}
+
+func (conf confCSV) newReader(reader io.Reader) *csv.Reader {
+	r := csv.NewReader(reader)
+	r.Comma = conf.separator
+	r.Comment = conf.comment
+
+	return r
+}
+
+// columnNATokens returns common NA-tokens together with NA-tokens from the schema of the column.
+func (conf confCSV) columnNATokens(name string) []string {
+	tokens := append([]string{}, conf.naTokens...)
+
+	return append(tokens, conf.schema[name].NATokens...)
+}
+
+func (conf confCSV) detectColumnTypes(records [][]string) []string {
+	rows := records
+	if conf.inferRows > 0 && len(rows) > conf.inferRows {
+		rows = rows[:conf.inferRows]
+	}
+
+	naTokens := make([][]string, len(conf.colNames))
+	for i, name := range conf.colNames {
+		naTokens[i] = conf.columnNATokens(name)
+	}
 
-		if slices.Contains(boolConv.TrueValues(), templateRow[i]) ||
-			slices.Contains(boolConv.TrueValues(), templateRow[i]) {
-			types[i] = "boolean"
-			continue
+	types := detectTypes(rows, len(conf.colNames), naTokens, vector.DefaultStringToBoolConverter())
+	for i, name := range conf.colNames {
+		if schema, ok := conf.schema[name]; ok && schema.Type != "" {
+			types[i] = schema.Type
 		}
 	}
 
 	return types
 }
 
-func defaultTypes(length int) []string {
-	types := make([]string, length)
+func (conf confCSV) recordsToDataframe(records [][]string) *Dataframe {
+	colNum := len(conf.colNames)
+
+	vecs := make([]vector.Vector, colNum)
+	for i := 0; i < colNum; i++ {
+		schema := conf.schema[conf.colNames[i]]
+		naTokens := conf.columnNATokens(conf.colNames[i])
+
+		arr := make([]string, len(records))
+		na := make([]bool, len(records))
+		for j, record := range records {
+			arr[j] = record[i]
+			na[j] = slices.Contains(naTokens, record[i])
+		}
+
+		vecOptions := []vector.Option{}
+		if schema.TimeFormat != "" {
+			vecOptions = append(vecOptions, vector.OptionTimeFormat(schema.TimeFormat),
+				vector.OptionDateFormat(schema.TimeFormat))
+		}
+		vecs[i] = vector.StringWithNA(arr, na, vecOptions...)
+	}
+	vecs = convertVectors(vecs, conf.colTypes)
+
+	dfOptions := append([]Option{}, conf.dfOptions...)
+	dfOptions = append(dfOptions, OptionColumnNames(conf.colNames))
+
+	return New(vecs, dfOptions...)
+}
+
+func defaultCSVColumnNames(length int) []string {
+	names := make([]string, length)
 	for i := 0; i < length; i++ {
-		types[i] = "string"
+		names[i] = strconv.Itoa(i)
+	}
+
+	return names
+}
+
+// detectTypes detects column types by the rows provided. Empty values and NA-tokens are skipped. If a column has
+// values of different numeric types, it becomes float. If there are no values to detect the type, it is string.
+func detectTypes(rows [][]string, colNum int, naTokens [][]string, boolConv vector.StringToBooleanConverter) []string {
+	types := make([]string, colNum)
+	for i := 0; i < colNum; i++ {
+		colType := ""
+		for _, row := range rows {
+			if row[i] == "" || slices.Contains(naTokens[i], row[i]) {
+				continue
+			}
+
+			colType = combineTypes(colType, detectType(row[i], boolConv))
+			if colType == "string" {
+				break
+			}
+		}
+
+		if colType == "" {
+			colType = "string"
+		}
+		types[i] = colType
 	}
 
 	return types
 }
 
+func detectType(value string, boolConv vector.StringToBooleanConverter) string {
+	if _, err := strconv.Atoi(value); err == nil {
+		return "integer"
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return "float"
+	}
+
+	if slices.Contains(boolConv.TrueValues(), value) ||
+		slices.Contains(boolConv.FalseValues(), value) {
+		return "boolean"
+	}
+
+	return "string"
+}
+
+func combineTypes(current, next string) string {
+	if current == "" || current == next {
+		return next
+	}
+
+	if (current == "integer" || current == "float") && (next == "integer" || next == "float") {
+		return "float"
+	}
+
+	return "string"
+}
+
 func convertVectors(vecs []vector.Vector, types []string) []vector.Vector {
 	for i, vec := range vecs {
 		switch types[i] {
@@ -160,6 +360,14 @@ func convertVectors(vecs []vector.Vector, types []string) []vector.Vector {
 			vecs[i] = vec.AsFloat()
 		case "boolean":
 			vecs[i] = vec.AsBoolean()
+		case "time":
+			vecs[i] = vec.AsTime()
+		case "factor":
+			vecs[i] = vec.AsFactor()
+		case "date":
+			vecs[i] = vec.AsDate()
+		case "duration":
+			vecs[i] = vec.AsDuration()
 		}
 	}
 
@@ -183,19 +391,53 @@ func (df *Dataframe) ToCSVFile(filename string, options ...Option) (err error) {
 	return nil
 }
 
+// ToCSV writes the dataframe to a CSV-writer.
+//
+// Possible options are:
+//   - CSVOptionSeparator(separator rune) - if you need a separator which differs from default one (",").
+//   - CSVOptionNAString(na string) - a string to write instead of NA-values ("NA" by default).
+//   - CSVOptionWriteHeader(write bool) - write column names as the first line (true by default).
+//   - CSVOptionQuoteAll(quote bool) - quote all fields, not only the ones which require it.
+//   - CSVOptionUseCRLF(useCRLF bool) - use \r\n as the line terminator.
+//
+// Float values are written with full precision. Values of other types are written as strings, so time, date,
+// duration and factor columns are read back by FromCSV as strings unless their types are set by CSVOptionSchema.
 func (df *Dataframe) ToCSV(writer io.Writer, options ...Option) error {
-	csvWriter := csv.NewWriter(writer)
+	confOptions := make([]ConfOption, len(options))
+	for i, option := range options {
+		confOptions[i] = ConfOption{option.Key(), option.Value()}
+	}
+	conf := combineCSVConfig(confOptions...)
+
+	var csvWriter csvRecordWriter
+	if conf.quoteAll {
+		csvWriter = &quotingCSVWriter{
+			writer:    bufio.NewWriter(writer),
+			separator: conf.separator,
+			useCRLF:   conf.useCRLF,
+		}
+	} else {
+		stdWriter := csv.NewWriter(writer)
+		stdWriter.Comma = conf.separator
+		stdWriter.UseCRLF = conf.useCRLF
+		csvWriter = stdWriter
+	}
 
-	for _, option := range options {
-		if option.Key() == optionCSVSeparator {
-			csvWriter.Comma = option.Value().(rune)
+	if conf.writeHeader {
+		if err := csvWriter.Write(df.columnNames); err != nil {
+			return err
 		}
 	}
 
-	record := make([]string, df.ColNum())
-	for row := 1; row <= df.RowNum(); row++ {
-		for col := 0; col < df.ColNum(); col++ {
-			record[col] = df.columns[col].StrForElem(row)
+	columns := make([][]string, df.colNum)
+	for i, column := range df.columns {
+		columns[i] = csvColumnStrings(column, conf.naString)
+	}
+
+	record := make([]string, df.colNum)
+	for row := 0; row < df.rowNum; row++ {
+		for col := 0; col < df.colNum; col++ {
+			record[col] = columns[col][row]
 		}
 		err := csvWriter.Write(record)
 		if err != nil {
@@ -205,7 +447,90 @@ func (df *Dataframe) ToCSV(writer io.Writer, options ...Option) error {
 
 	csvWriter.Flush()
 
-	return nil
+	return csvWriter.Error()
+}
+
+func csvColumnStrings(column vector.Vector, naString string) []string {
+	isNA := column.IsNA()
+
+	var floats []float64
+	if column.Type() == "float" {
+		floats, _ = column.Floats()
+	}
+
+	values := make([]string, column.Len())
+	for i := range values {
+		switch {
+		case isNA[i]:
+			values[i] = naString
+		case floats != nil:
+			values[i] = csvFloatString(floats[i])
+		default:
+			values[i] = column.StrForElem(i + 1)
+		}
+	}
+
+	return values
+}
+
+// csvFloatString always writes a decimal point for finite values, so float columns are read back as float.
+func csvFloatString(f float64) string {
+	str := strconv.FormatFloat(f, 'f', -1, 64)
+	if !math.IsInf(f, 0) && !math.IsNaN(f) && !strings.Contains(str, ".") {
+		str += ".0"
+	}
+
+	return str
+}
+
+type csvRecordWriter interface {
+	Write(record []string) error
+	Flush()
+	Error() error
+}
+
+// quotingCSVWriter writes CSV-records with all fields quoted.
+type quotingCSVWriter struct {
+	writer    *bufio.Writer
+	separator rune
+	useCRLF   bool
+	err       error
+}
+
+func (w *quotingCSVWriter) Write(record []string) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	for i, field := range record {
+		if i > 0 {
+			if _, w.err = w.writer.WriteRune(w.separator); w.err != nil {
+				return w.err
+			}
+		}
+
+		if _, w.err = w.writer.WriteString(`"` + strings.ReplaceAll(field, `"`, `""`) + `"`); w.err != nil {
+			return w.err
+		}
+	}
+
+	lineEnd := "\n"
+	if w.useCRLF {
+		lineEnd = "\r\n"
+	}
+	_, w.err = w.writer.WriteString(lineEnd)
+
+	return w.err
+}
+
+func (w *quotingCSVWriter) Flush() {
+	if w.err == nil {
+		w.err = w.writer.Flush()
+	}
+}
+
+func (w *quotingCSVWriter) Error() error {
+	return w.err
 }
 
 func CSVOptionSkipFirstLine(skip bool) ConfOption {
@@ -219,3 +544,35 @@ func CSVOptionSeparator(separator rune) ConfOption {
 func CSVOptionDataframeOptions(options ...Option) ConfOption {
 	return ConfOption{optionCSVDataframeOptions, options}
 }
+
+func CSVOptionSchema(schema map[string]CSVColumnSchema) ConfOption {
+	return ConfOption{optionCSVSchema, schema}
+}
+
+func CSVOptionInferRows(n int) ConfOption {
+	return ConfOption{optionCSVInferRows, n}
+}
+
+func CSVOptionNATokens(tokens ...string) ConfOption {
+	return ConfOption{optionCSVNATokens, tokens}
+}
+
+func CSVOptionNAString(na string) ConfOption {
+	return ConfOption{optionCSVNAString, na}
+}
+
+func CSVOptionWriteHeader(write bool) ConfOption {
+	return ConfOption{optionCSVWriteHeader, write}
+}
+
+func CSVOptionQuoteAll(quote bool) ConfOption {
+	return ConfOption{optionCSVQuoteAll, quote}
+}
+
+func CSVOptionUseCRLF(useCRLF bool) ConfOption {
+	return ConfOption{optionCSVUseCRLF, useCRLF}
+}
+
+func CSVOptionComment(comment rune) ConfOption {
+	return ConfOption{optionCSVComment, comment}
+}
@@ -2,10 +2,13 @@ package dataframe
 
 import (
 	"fmt"
+	"io"
 	"logarithmotechnia/vector"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestFromCSVFile(t *testing.T) {
@@ -58,6 +61,46 @@ func TestCSVOptions(t *testing.T) {
 			reference: ConfOption{optionCSVDataframeOptions,
 				[]Option{OptionColumnNames([]string{"id", "price"})}},
 		},
+		{
+			name:      "CSVOptionSchema",
+			result:    CSVOptionSchema(map[string]CSVColumnSchema{"id": {Type: "integer"}}),
+			reference: ConfOption{optionCSVSchema, map[string]CSVColumnSchema{"id": {Type: "integer"}}},
+		},
+		{
+			name:      "CSVOptionInferRows",
+			result:    CSVOptionInferRows(10),
+			reference: ConfOption{optionCSVInferRows, 10},
+		},
+		{
+			name:      "CSVOptionNATokens",
+			result:    CSVOptionNATokens("", "NA"),
+			reference: ConfOption{optionCSVNATokens, []string{"", "NA"}},
+		},
+		{
+			name:      "CSVOptionNAString",
+			result:    CSVOptionNAString("null"),
+			reference: ConfOption{optionCSVNAString, "null"},
+		},
+		{
+			name:      "CSVOptionWriteHeader",
+			result:    CSVOptionWriteHeader(false),
+			reference: ConfOption{optionCSVWriteHeader, false},
+		},
+		{
+			name:      "CSVOptionQuoteAll",
+			result:    CSVOptionQuoteAll(true),
+			reference: ConfOption{optionCSVQuoteAll, true},
+		},
+		{
+			name:      "CSVOptionUseCRLF",
+			result:    CSVOptionUseCRLF(true),
+			reference: ConfOption{optionCSVUseCRLF, true},
+		},
+		{
+			name:      "CSVOptionComment",
+			result:    CSVOptionComment('#'),
+			reference: ConfOption{optionCSVComment, '#'},
+		},
 	}
 
 	for _, data := range testData {
@@ -69,3 +112,267 @@ func TestCSVOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestFromCSV(t *testing.T) {
+	csvData := "id,price,active,date,note\n" +
+		"1,10,t,2022-01-15,a\n" +
+		"2,10.5,f,-,b\n" +
+		"3,n/a,true,2022-03-01,c\n"
+
+	testData := []struct {
+		name    string
+		prefix  string
+		options []ConfOption
+		columns []vector.Vector
+	}{
+		{
+			name: "first row inference",
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+				vector.IntegerWithNA([]int{10, 0, 0}, []bool{false, true, true}),
+				vector.Boolean([]bool{true, false, true}),
+				vector.String([]string{"2022-01-15", "-", "2022-03-01"}),
+				vector.String([]string{"a", "b", "c"}),
+			},
+		},
+		{
+			name:    "all rows inference",
+			options: []ConfOption{CSVOptionInferRows(0)},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+				vector.String([]string{"10", "10.5", "n/a"}),
+				vector.Boolean([]bool{true, false, true}),
+				vector.String([]string{"2022-01-15", "-", "2022-03-01"}),
+				vector.String([]string{"a", "b", "c"}),
+			},
+		},
+		{
+			name:    "na tokens and comments",
+			prefix:  "# comment line\n",
+			options: []ConfOption{CSVOptionInferRows(0), CSVOptionNATokens("n/a", "-"), CSVOptionComment('#')},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+				vector.FloatWithNA([]float64{10, 10.5, 0}, []bool{false, false, true}),
+				vector.Boolean([]bool{true, false, true}),
+				vector.StringWithNA([]string{"2022-01-15", "", "2022-03-01"}, []bool{false, true, false}),
+				vector.String([]string{"a", "b", "c"}),
+			},
+		},
+		{
+			name: "schema",
+			options: []ConfOption{
+				CSVOptionInferRows(0),
+				CSVOptionSchema(map[string]CSVColumnSchema{
+					"price":  {NATokens: []string{"n/a"}},
+					"active": {Type: "boolean"},
+					"date":   {Type: "time", TimeFormat: "2006-01-02", NATokens: []string{"-"}},
+					"note":   {Type: "factor"},
+				}),
+			},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+				vector.FloatWithNA([]float64{10, 10.5, 0}, []bool{false, false, true}),
+				vector.Boolean([]bool{true, false, true}),
+				vector.TimeWithNA([]time.Time{
+					time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC),
+					{},
+					time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+				}, []bool{false, true, false}),
+				vector.Factor([]string{"a", "b", "c"}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			df, err := FromCSV(strings.NewReader(data.prefix+csvData), data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Dataframe columns (%v) are not equal to expected (%v)",
+					df.columns, data.columns))
+			}
+		})
+	}
+}
+
+func TestFromCSV_NATokens(t *testing.T) {
+	csvData := "name,value\n,1\nnull,N/A\nNA,3\nN/A,\n"
+
+	testData := []struct {
+		name    string
+		options []ConfOption
+		columns []vector.Vector
+	}{
+		{
+			name:    "default",
+			options: []ConfOption{CSVOptionInferRows(0)},
+			columns: []vector.Vector{
+				vector.StringWithNA([]string{"", "null", "", "N/A"}, []bool{false, false, true, false}),
+				vector.String([]string{"1", "N/A", "3", ""}),
+			},
+		},
+		{
+			name:    "common tokens",
+			options: []ConfOption{CSVOptionInferRows(0), CSVOptionNATokens(CSVCommonNATokens()...)},
+			columns: []vector.Vector{
+				vector.StringWithNA([]string{"", "", "", ""}, []bool{true, true, true, true}),
+				vector.IntegerWithNA([]int{1, 0, 3, 0}, []bool{false, true, false, true}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			df, err := FromCSV(strings.NewReader(csvData), data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(df.columns, data.columns) {
+				t.Error(fmt.Sprintf("Dataframe columns (%v) are not equal to expected (%v)",
+					df.columns, data.columns))
+			}
+		})
+	}
+}
+
+func TestCSVChunkReader_Next(t *testing.T) {
+	csvData := "id;name\n1;a\n2;b\n3;c\n4;d\n5;e\n"
+
+	reader := NewCSVChunkReader(strings.NewReader(csvData), 2, CSVOptionSeparator(';'))
+
+	expected := [][]vector.Vector{
+		{vector.Integer([]int{1, 2}), vector.String([]string{"a", "b"})},
+		{vector.Integer([]int{3, 4}), vector.String([]string{"c", "d"})},
+		{vector.Integer([]int{5}), vector.String([]string{"e"})},
+	}
+
+	for i, columns := range expected {
+		df, err := reader.Next()
+		if err != nil {
+			t.Error(fmt.Sprintf("Unexpected error in chunk %d: %v", i+1, err))
+			return
+		}
+
+		if !vector.CompareVectorArrs(df.columns, columns) {
+			t.Error(fmt.Sprintf("Chunk %d columns (%v) are not equal to expected (%v)", i+1, df.columns, columns))
+		}
+
+		if !reflect.DeepEqual(df.columnNames, []string{"id", "name"}) {
+			t.Error(fmt.Sprintf("Chunk %d column names (%v) are not equal to expected (%v)",
+				i+1, df.columnNames, []string{"id", "name"}))
+		}
+	}
+
+	_, err := reader.Next()
+	if err != io.EOF {
+		t.Error(fmt.Sprintf("Error (%v) is not io.EOF", err))
+	}
+}
+
+func TestDataframe_ToCSV(t *testing.T) {
+	df := New([]Column{
+		{"id", vector.IntegerWithNA([]int{1, 0}, []bool{false, true})},
+		{"name", vector.String([]string{"a,b", "c\"d"})},
+		{"value", vector.Float([]float64{1.23456789, 2})},
+	})
+
+	testData := []struct {
+		name     string
+		options  []Option
+		expected string
+	}{
+		{
+			name:     "default",
+			expected: "id,name,value\n1,\"a,b\",1.23456789\nNA,\"c\"\"d\",2.0\n",
+		},
+		{
+			name:     "na string without header",
+			options:  []Option{CSVOptionNAString(""), CSVOptionWriteHeader(false), CSVOptionSeparator(';')},
+			expected: "1;a,b;1.23456789\n;\"c\"\"d\";2.0\n",
+		},
+		{
+			name:     "quote all with crlf",
+			options:  []Option{CSVOptionQuoteAll(true), CSVOptionUseCRLF(true)},
+			expected: "\"id\",\"name\",\"value\"\r\n\"1\",\"a,b\",\"1.23456789\"\r\n\"NA\",\"c\"\"d\",\"2.0\"\r\n",
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+
+			err := df.ToCSV(builder, data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+			}
+
+			if builder.String() != data.expected {
+				t.Error(fmt.Sprintf("CSV (%q) is not equal to expected (%q)", builder.String(), data.expected))
+			}
+		})
+	}
+}
+
+func TestCSV_RoundTrip(t *testing.T) {
+	df := New([]Column{
+		{"id", vector.IntegerWithNA([]int{1, 0, 3}, []bool{false, true, false})},
+		{"name", vector.StringWithNA([]string{"a,b", "", "c\"d"}, []bool{false, true, false})},
+		{"value", vector.FloatWithNA([]float64{1, 0, 0.1}, []bool{false, true, false})},
+		{"ratio", vector.Float([]float64{1.23456789, -2, 100})},
+		{"flag", vector.BooleanWithNA([]bool{true, false, false}, []bool{false, false, true})},
+		{"comment", vector.String([]string{"", "NA-free", ""})},
+	})
+
+	testData := []struct {
+		name    string
+		options []ConfOption
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:    "quote all",
+			options: []ConfOption{CSVOptionQuoteAll(true)},
+		},
+		{
+			name:    "na string",
+			options: []ConfOption{CSVOptionNAString("null")},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			writeOptions := make([]Option, len(data.options))
+			for i, option := range data.options {
+				writeOptions[i] = option
+			}
+
+			builder := &strings.Builder{}
+			if err := df.ToCSV(builder, writeOptions...); err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			newDf, err := FromCSV(strings.NewReader(builder.String()), data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(newDf.columns, df.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to original (%v)", newDf.columns, df.columns))
+			}
+
+			if !reflect.DeepEqual(newDf.columnNames, df.columnNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to original (%v)",
+					newDf.columnNames, df.columnNames))
+			}
+		})
+	}
+}
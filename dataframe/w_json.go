@@ -0,0 +1,573 @@
+package dataframe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"logarithmotechnia/vector"
+	"math"
+	"strconv"
+	"time"
+)
+
+const optionJSONOrientation = "jsonOrientation"
+const optionJSONTimeFormat = "jsonTimeFormat"
+const optionJSONDataframeOptions = "jsonDataframeOptions"
+
+const (
+	// JSONRecords is an array of objects where every object is a row: [{"a": 1, "b": "x"}, {"a": 2, "b": "y"}].
+	// For NDJSON every line is a row.
+	JSONRecords = "records"
+	// JSONColumns is an object of arrays where every array is a column: {"a": [1, 2], "b": ["x", "y"]}.
+	// For NDJSON every line is an object with one or more columns.
+	JSONColumns = "columns"
+)
+
+type confJSON struct {
+	orientation string
+	timeFormat  string
+	dfOptions   []Option
+}
+
+// jsonObject is a JSON-object with the preserved order of keys.
+type jsonObject struct {
+	keys   []string
+	values []any
+}
+
+// FromJSON loads data from a JSON-reader to a dataframe. Column types are inferred from values: integer, float,
+// boolean, time (strings in the time format), string, vector (arrays) or any (objects and mixed values). Nulls and
+// missing values become NA.
+//
+// Possible options are:
+//   - JSONOptionOrientation(orientation string) - JSONRecords (default) or JSONColumns.
+//   - JSONOptionTimeFormat(format string) - a layout for parsing and writing time values (time.RFC3339 by default).
+//   - JSONOptionDataframeOptions(options ...Option) - options to pass to the new dataframe.
+func FromJSON(reader io.Reader, options ...ConfOption) (*Dataframe, error) {
+	conf := combineJSONConfig(options...)
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	value, err := decodeJSONValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.orientation == JSONColumns {
+		obj, ok := value.(*jsonObject)
+		if !ok {
+			return nil, errors.New("JSON data is not an object of columns")
+		}
+
+		return conf.columnsToDataframe([]*jsonObject{obj})
+	}
+
+	arr, ok := value.([]any)
+	if !ok {
+		return nil, errors.New("JSON data is not an array of records")
+	}
+
+	records := make([]*jsonObject, len(arr))
+	for i, elem := range arr {
+		record, ok := elem.(*jsonObject)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("record %d is not an object", i+1))
+		}
+		records[i] = record
+	}
+
+	return conf.recordsToDataframe(records), nil
+}
+
+// FromNDJSON loads data from a newline-delimited JSON-reader to a dataframe. Every line has to contain an object.
+// In the columns orientation an error is returned if a column name repeats or columns differ in length.
+// Options are the same as for FromJSON().
+func FromNDJSON(reader io.Reader, options ...ConfOption) (*Dataframe, error) {
+	conf := combineJSONConfig(options...)
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	objects := []*jsonObject{}
+	for decoder.More() {
+		value, err := decodeJSONValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, ok := value.(*jsonObject)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("line %d does not contain an object", len(objects)+1))
+		}
+		objects = append(objects, obj)
+	}
+
+	if conf.orientation == JSONColumns {
+		return conf.columnsToDataframe(objects)
+	}
+
+	return conf.recordsToDataframe(objects), nil
+}
+
+// ToJSON writes the dataframe to a JSON-writer. NA-values are written as null.
+//
+// Possible options are:
+//   - JSONOptionOrientation(orientation string) - JSONRecords (default) or JSONColumns.
+//   - JSONOptionTimeFormat(format string) - a layout for writing time values (time.RFC3339 by default).
+func (df *Dataframe) ToJSON(writer io.Writer, options ...ConfOption) error {
+	conf := combineJSONConfig(options...)
+	buf := bufio.NewWriter(writer)
+
+	columns := df.jsonColumns(conf)
+
+	var err error
+	if conf.orientation == JSONColumns {
+		values := make([]any, len(columns))
+		for i, column := range columns {
+			values[i] = column
+		}
+		err = writeJSONObject(buf, df.columnNames, values)
+	} else {
+		err = buf.WriteByte('[')
+		for row := 0; row < df.rowNum && err == nil; row++ {
+			if row > 0 {
+				err = buf.WriteByte(',')
+			}
+			if err == nil {
+				err = writeJSONObject(buf, df.columnNames, jsonRow(columns, row))
+			}
+		}
+		if err == nil {
+			err = buf.WriteByte(']')
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+// ToNDJSON writes the dataframe to a newline-delimited JSON-writer. With JSONRecords orientation every line contains
+// a row, with JSONColumns - a column. Options are the same as for ToJSON().
+func (df *Dataframe) ToNDJSON(writer io.Writer, options ...ConfOption) error {
+	conf := combineJSONConfig(options...)
+	buf := bufio.NewWriter(writer)
+
+	columns := df.jsonColumns(conf)
+
+	if conf.orientation == JSONColumns {
+		for i, name := range df.columnNames {
+			if err := writeJSONObject(buf, []string{name}, []any{columns[i]}); err != nil {
+				return err
+			}
+			if err := buf.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+	} else {
+		for row := 0; row < df.rowNum; row++ {
+			if err := writeJSONObject(buf, df.columnNames, jsonRow(columns, row)); err != nil {
+				return err
+			}
+			if err := buf.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+	}
+
+	return buf.Flush()
+}
+
+func combineJSONConfig(options ...ConfOption) confJSON {
+	conf := confJSON{
+		orientation: JSONRecords,
+		timeFormat:  time.RFC3339,
+		dfOptions:   []Option{},
+	}
+
+	for _, option := range options {
+		switch option.Key() {
+		case optionJSONOrientation:
+			conf.orientation = option.Value().(string)
+		case optionJSONTimeFormat:
+			conf.timeFormat = option.Value().(string)
+		case optionJSONDataframeOptions:
+			conf.dfOptions = option.Value().([]Option)
+		}
+	}
+
+	return conf
+}
+
+func (conf confJSON) recordsToDataframe(records []*jsonObject) *Dataframe {
+	names := []string{}
+	columnValues := map[string][]any{}
+
+	for row, record := range records {
+		for i, key := range record.keys {
+			values, ok := columnValues[key]
+			if !ok {
+				names = append(names, key)
+				values = make([]any, len(records))
+				columnValues[key] = values
+			}
+			values[row] = record.values[i]
+		}
+	}
+
+	vecs := make([]vector.Vector, len(names))
+	for i, name := range names {
+		vecs[i] = conf.jsonValuesToVector(columnValues[name])
+	}
+
+	return New(vecs, append(append([]Option{}, conf.dfOptions...), OptionColumnNames(names))...)
+}
+
+// columnsToDataframe creates a dataframe from objects of columns. Column names have to be unique across all objects
+// and all columns have to be of the same length.
+func (conf confJSON) columnsToDataframe(objects []*jsonObject) (*Dataframe, error) {
+	names := []string{}
+	vecs := []vector.Vector{}
+
+	for _, obj := range objects {
+		for i, key := range obj.keys {
+			values, ok := obj.values[i].([]any)
+			if !ok {
+				return nil, errors.New(fmt.Sprintf("column %s is not an array", key))
+			}
+
+			if strPosInSlice(names, key) != -1 {
+				return nil, errors.New(fmt.Sprintf("column %s is duplicated", key))
+			}
+
+			if len(vecs) > 0 && len(values) != vecs[0].Len() {
+				return nil, errors.New(fmt.Sprintf("column %s has length %d instead of %d", key, len(values),
+					vecs[0].Len()))
+			}
+
+			names = append(names, key)
+			vecs = append(vecs, conf.jsonValuesToVector(values))
+		}
+	}
+
+	return New(vecs, append(append([]Option{}, conf.dfOptions...), OptionColumnNames(names))...), nil
+}
+
+// jsonValuesToVector converts decoded JSON-values to a vector of the most suitable type.
+func (conf confJSON) jsonValuesToVector(values []any) vector.Vector {
+	length := len(values)
+	na := make([]bool, length)
+
+	valueType := ""
+	for i, value := range values {
+		if value == nil {
+			na[i] = true
+			continue
+		}
+
+		valueType = combineJSONTypes(valueType, conf.jsonValueType(value))
+	}
+
+	switch valueType {
+	case "":
+		return vector.NA(length)
+	case "integer":
+		data := make([]int, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i], _ = strconv.Atoi(value.(json.Number).String())
+			}
+		}
+		return vector.IntegerWithNA(data, na)
+	case "float":
+		data := make([]float64, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i], _ = value.(json.Number).Float64()
+			}
+		}
+		return vector.FloatWithNA(data, na)
+	case "boolean":
+		data := make([]bool, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i] = value.(bool)
+			}
+		}
+		return vector.BooleanWithNA(data, na)
+	case "time":
+		data := make([]time.Time, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i], _ = time.Parse(conf.timeFormat, value.(string))
+			}
+		}
+		return vector.TimeWithNA(data, na, vector.OptionTimeFormat(conf.timeFormat))
+	case "string":
+		data := make([]string, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i] = value.(string)
+			}
+		}
+		return vector.StringWithNA(data, na)
+	case "vector":
+		data := make([]vector.Vector, length)
+		for i, value := range values {
+			if !na[i] {
+				data[i] = conf.jsonValuesToVector(value.([]any))
+			}
+		}
+		return vector.VectorVector(data)
+	}
+
+	data := make([]any, length)
+	for i, value := range values {
+		if !na[i] {
+			data[i] = plainJSONValue(value)
+		}
+	}
+
+	return vector.AnyWithNA(data, na)
+}
+
+func (conf confJSON) jsonValueType(value any) string {
+	switch val := value.(type) {
+	case json.Number:
+		if _, err := strconv.Atoi(val.String()); err == nil {
+			return "integer"
+		}
+		return "float"
+	case bool:
+		return "boolean"
+	case string:
+		if _, err := time.Parse(conf.timeFormat, val); err == nil {
+			return "time"
+		}
+		return "string"
+	case []any:
+		return "vector"
+	}
+
+	return "any"
+}
+
+func combineJSONTypes(current, next string) string {
+	if current == "" || current == next {
+		return next
+	}
+
+	if (current == "integer" || current == "float") && (next == "integer" || next == "float") {
+		return "float"
+	}
+
+	if (current == "time" || current == "string") && (next == "time" || next == "string") {
+		return "string"
+	}
+
+	return "any"
+}
+
+// plainJSONValue converts a decoded JSON-value to values of standard Go types (float64, string, bool,
+// []any, map[string]any).
+func plainJSONValue(value any) any {
+	switch val := value.(type) {
+	case json.Number:
+		if num, err := strconv.Atoi(val.String()); err == nil {
+			return num
+		}
+		num, _ := val.Float64()
+		return num
+	case []any:
+		arr := make([]any, len(val))
+		for i, elem := range val {
+			arr[i] = plainJSONValue(elem)
+		}
+		return arr
+	case *jsonObject:
+		obj := map[string]any{}
+		for i, key := range val.keys {
+			obj[key] = plainJSONValue(val.values[i])
+		}
+		return obj
+	}
+
+	return value
+}
+
+// decodeJSONValue decodes the next JSON-value preserving the order of object keys.
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '[':
+		arr := []any{}
+		for decoder.More() {
+			elem, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, elem)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+
+		return arr, nil
+	case '{':
+		obj := &jsonObject{}
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			obj.keys = append(obj.keys, keyToken.(string))
+			obj.values = append(obj.values, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+
+		return obj, nil
+	}
+
+	return nil, errors.New(fmt.Sprintf("unexpected JSON delimiter %v", delim))
+}
+
+// jsonColumns converts columns of the dataframe to arrays of values ready for JSON-encoding.
+func (df *Dataframe) jsonColumns(conf confJSON) [][]any {
+	columns := make([][]any, df.colNum)
+	for i, column := range df.columns {
+		columns[i] = vectorToJSONValues(column, conf)
+	}
+
+	return columns
+}
+
+func vectorToJSONValues(vec vector.Vector, conf confJSON) []any {
+	isNA := vec.IsNA()
+	values := make([]any, vec.Len())
+
+	switch vec.Type() {
+	case "integer":
+		data, _ := vec.Integers()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "float":
+		data, _ := vec.Floats()
+		for i := range values {
+			if !math.IsNaN(data[i]) && !math.IsInf(data[i], 0) {
+				values[i] = data[i]
+			}
+		}
+	case "boolean":
+		data, _ := vec.Booleans()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "string":
+		data, _ := vec.Strings()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "time":
+		data, _ := vec.Times()
+		for i := range values {
+			values[i] = data[i].Format(conf.timeFormat)
+		}
+	case "date", "duration":
+		for i := range values {
+			values[i] = vec.StrForElem(i + 1)
+		}
+	case "vector":
+		data := vec.Payload().(vector.Vectorable).Vectors()
+		for i := range values {
+			if data[i] != nil {
+				values[i] = vectorToJSONValues(data[i], conf)
+			}
+		}
+	default:
+		for i := range values {
+			values[i] = vec.Pick(i + 1)
+		}
+	}
+
+	for i := range values {
+		if isNA[i] {
+			values[i] = nil
+		}
+	}
+
+	return values
+}
+
+func jsonRow(columns [][]any, row int) []any {
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		values[i] = column[row]
+	}
+
+	return values
+}
+
+func writeJSONObject(buf *bufio.Writer, keys []string, values []any) error {
+	b := bytes.Buffer{}
+	b.WriteByte('{')
+
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		encodedValue, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+
+		b.Write(encodedKey)
+		b.WriteByte(':')
+		b.Write(encodedValue)
+	}
+
+	b.WriteByte('}')
+	_, err := buf.Write(b.Bytes())
+
+	return err
+}
+
+func JSONOptionOrientation(orientation string) ConfOption {
+	return ConfOption{optionJSONOrientation, orientation}
+}
+
+func JSONOptionTimeFormat(format string) ConfOption {
+	return ConfOption{optionJSONTimeFormat, format}
+}
+
+func JSONOptionDataframeOptions(options ...Option) ConfOption {
+	return ConfOption{optionJSONDataframeOptions, options}
+}
@@ -0,0 +1,280 @@
+package dataframe
+
+import (
+	"fmt"
+	"logarithmotechnia/vector"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromJSON(t *testing.T) {
+	expectedColumns := []vector.Vector{
+		vector.IntegerWithNA([]int{1, 0, 3}, []bool{false, true, false}),
+		vector.StringWithNA([]string{"x", "y", ""}, []bool{false, false, true}),
+		vector.Boolean([]bool{true, false, true}),
+		vector.TimeWithNA([]time.Time{
+			time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			{},
+			time.Date(2022, 1, 3, 10, 0, 0, 0, time.UTC),
+		}, []bool{false, true, false}),
+		vector.FloatWithNA([]float64{0, 1.5, 2}, []bool{true, false, false}),
+	}
+	expectedNames := []string{"a", "b", "c", "d", "f"}
+
+	testData := []struct {
+		name    string
+		data    string
+		fn      func(string, ...ConfOption) (*Dataframe, error)
+		options []ConfOption
+	}{
+		{
+			name: "records",
+			data: `[{"a": 1, "b": "x", "c": true, "d": "2022-01-01T10:00:00Z"},
+				{"a": null, "b": "y", "c": false, "f": 1.5},
+				{"a": 3, "b": null, "c": true, "d": "2022-01-03T10:00:00Z", "f": 2}]`,
+			fn: func(data string, options ...ConfOption) (*Dataframe, error) {
+				return FromJSON(strings.NewReader(data), options...)
+			},
+		},
+		{
+			name: "columns",
+			data: `{"a": [1, null, 3], "b": ["x", "y", null], "c": [true, false, true],
+				"d": ["2022-01-01T10:00:00Z", null, "2022-01-03T10:00:00Z"], "f": [null, 1.5, 2]}`,
+			fn: func(data string, options ...ConfOption) (*Dataframe, error) {
+				return FromJSON(strings.NewReader(data), options...)
+			},
+			options: []ConfOption{JSONOptionOrientation(JSONColumns)},
+		},
+		{
+			name: "ndjson records",
+			data: `{"a": 1, "b": "x", "c": true, "d": "2022-01-01T10:00:00Z"}
+				{"a": null, "b": "y", "c": false, "f": 1.5}
+				{"a": 3, "b": null, "c": true, "d": "2022-01-03T10:00:00Z", "f": 2}`,
+			fn: func(data string, options ...ConfOption) (*Dataframe, error) {
+				return FromNDJSON(strings.NewReader(data), options...)
+			},
+		},
+		{
+			name: "ndjson columns",
+			data: `{"a": [1, null, 3], "b": ["x", "y", null]}
+				{"c": [true, false, true]}
+				{"d": ["2022-01-01T10:00:00Z", null, "2022-01-03T10:00:00Z"], "f": [null, 1.5, 2]}`,
+			fn: func(data string, options ...ConfOption) (*Dataframe, error) {
+				return FromNDJSON(strings.NewReader(data), options...)
+			},
+			options: []ConfOption{JSONOptionOrientation(JSONColumns)},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			df, err := data.fn(data.data, data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(df.columns, expectedColumns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", df.columns, expectedColumns))
+			}
+
+			if !reflect.DeepEqual(df.columnNames, expectedNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)", df.columnNames, expectedNames))
+			}
+		})
+	}
+}
+
+func TestFromJSON_Types(t *testing.T) {
+	df, err := FromJSON(strings.NewReader(`[
+		{"arr": [1, 2], "mixed": 1, "nums": 1, "obj": {"k": "v"}, "nulls": null},
+		{"arr": null, "mixed": "one", "nums": 2.5, "obj": null, "nulls": null}
+	]`))
+	if err != nil {
+		t.Error(fmt.Sprintf("Unexpected error: %v", err))
+		return
+	}
+
+	expectedColumns := []vector.Vector{
+		vector.VectorVector([]vector.Vector{vector.Integer([]int{1, 2}), nil}),
+		vector.Any([]any{1, "one"}),
+		vector.Float([]float64{1, 2.5}),
+		vector.AnyWithNA([]any{map[string]any{"k": "v"}, nil}, []bool{false, true}),
+		vector.NA(2),
+	}
+
+	if !vector.CompareVectorArrs(df.columns, expectedColumns) {
+		t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", df.columns, expectedColumns))
+	}
+
+	_, err = FromJSON(strings.NewReader(`{"a": [1]}`))
+	if err == nil {
+		t.Error("Error is expected for an object in records orientation")
+	}
+
+	_, err = FromJSON(strings.NewReader(`{"a": 1}`), JSONOptionOrientation(JSONColumns))
+	if err == nil {
+		t.Error("Error is expected for a non-array column")
+	}
+}
+
+func TestFromNDJSON_ColumnErrors(t *testing.T) {
+	testData := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "duplicate columns",
+			data: `{"a": [1, 2], "b": [3, 4]}
+				{"a": [5, 6]}`,
+		},
+		{
+			name: "longer column",
+			data: `{"a": [1, 2]}
+				{"b": [3, 4, 5]}`,
+		},
+		{
+			name: "shorter column",
+			data: `{"a": [1, 2], "b": [3]}`,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			_, err := FromNDJSON(strings.NewReader(data.data), JSONOptionOrientation(JSONColumns))
+			if err == nil {
+				t.Error("Error is expected")
+			}
+		})
+	}
+}
+
+func TestDataframe_ToJSON(t *testing.T) {
+	df := New([]Column{
+		{"a", vector.IntegerWithNA([]int{1, 0}, []bool{false, true})},
+		{"b", vector.StringWithNA([]string{"", "y"}, []bool{true, false})},
+		{"c", vector.FloatWithNA([]float64{1.5, 0}, []bool{false, true})},
+		{"d", vector.Time([]time.Time{
+			time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC),
+		})},
+		{"e", vector.VectorVector([]vector.Vector{vector.Boolean([]bool{true}), nil})},
+	})
+
+	testData := []struct {
+		name     string
+		ndjson   bool
+		options  []ConfOption
+		expected string
+	}{
+		{
+			name: "records",
+			expected: `[{"a":1,"b":null,"c":1.5,"d":"2022-01-01T10:00:00Z","e":[true]},` +
+				`{"a":null,"b":"y","c":null,"d":"2022-01-02T10:00:00Z","e":null}]`,
+		},
+		{
+			name:    "columns",
+			options: []ConfOption{JSONOptionOrientation(JSONColumns), JSONOptionTimeFormat("2006-01-02")},
+			expected: `{"a":[1,null],"b":[null,"y"],"c":[1.5,null],"d":["2022-01-01","2022-01-02"],` +
+				`"e":[[true],null]}`,
+		},
+		{
+			name:   "ndjson records",
+			ndjson: true,
+			expected: `{"a":1,"b":null,"c":1.5,"d":"2022-01-01T10:00:00Z","e":[true]}` + "\n" +
+				`{"a":null,"b":"y","c":null,"d":"2022-01-02T10:00:00Z","e":null}` + "\n",
+		},
+		{
+			name:    "ndjson columns",
+			ndjson:  true,
+			options: []ConfOption{JSONOptionOrientation(JSONColumns)},
+			expected: `{"a":[1,null]}` + "\n" + `{"b":[null,"y"]}` + "\n" + `{"c":[1.5,null]}` + "\n" +
+				`{"d":["2022-01-01T10:00:00Z","2022-01-02T10:00:00Z"]}` + "\n" + `{"e":[[true],null]}` + "\n",
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+
+			var err error
+			if data.ndjson {
+				err = df.ToNDJSON(builder, data.options...)
+			} else {
+				err = df.ToJSON(builder, data.options...)
+			}
+
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+			}
+
+			if builder.String() != data.expected {
+				t.Error(fmt.Sprintf("JSON (%s) is not equal to expected (%s)", builder.String(), data.expected))
+			}
+		})
+	}
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	df := New([]Column{
+		{"a", vector.IntegerWithNA([]int{1, 0, 3}, []bool{false, true, false})},
+		{"b", vector.StringWithNA([]string{"x", "", "z"}, []bool{false, true, false})},
+		{"c", vector.Float([]float64{1.5, 0.1, 2.25})},
+		{"d", vector.BooleanWithNA([]bool{true, false, false}, []bool{false, false, true})},
+	})
+
+	for _, orientation := range []string{JSONRecords, JSONColumns} {
+		t.Run(orientation, func(t *testing.T) {
+			builder := &strings.Builder{}
+			if err := df.ToJSON(builder, JSONOptionOrientation(orientation)); err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			newDf, err := FromJSON(strings.NewReader(builder.String()), JSONOptionOrientation(orientation))
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(newDf.columns, df.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to original (%v)", newDf.columns, df.columns))
+			}
+		})
+	}
+}
+
+func TestJSONOptions(t *testing.T) {
+	testData := []struct {
+		name      string
+		result    Option
+		reference Option
+	}{
+		{
+			name:      "JSONOptionOrientation",
+			result:    JSONOptionOrientation(JSONColumns),
+			reference: ConfOption{optionJSONOrientation, JSONColumns},
+		},
+		{
+			name:      "JSONOptionTimeFormat",
+			result:    JSONOptionTimeFormat(time.RFC822),
+			reference: ConfOption{optionJSONTimeFormat, time.RFC822},
+		},
+		{
+			name:      "JSONOptionDataframeOptions",
+			result:    JSONOptionDataframeOptions(OptionColumnNames([]string{"a"})),
+			reference: ConfOption{optionJSONDataframeOptions, []Option{OptionColumnNames([]string{"a"})}},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.result, data.reference) {
+				t.Error(fmt.Sprintf("Resulting conf option (%v) does not match reference (%v)",
+					data.result, data.reference))
+			}
+		})
+	}
+}
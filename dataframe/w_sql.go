@@ -7,37 +7,82 @@ import (
 	"logarithmotechnia/vector"
 	"math"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const optionSQLDataframeOptions = "sqlDataframeOptions"
 const optionSQLDataframeTransformers = "sqlDataframeTransformers"
+const optionSQLMode = "sqlMode"
+const optionSQLCreateTable = "sqlCreateTable"
+const optionSQLKeyColumns = "sqlKeyColumns"
+const optionSQLBatchSize = "sqlBatchSize"
+const optionSQLTimeFormat = "sqlTimeFormat"
 const SQLTypeDateTime = "DATETIME"
 const SQLTypeDate = "DATE"
 
 type transformerFunc = func(vector.Vector) vector.Vector
 
+const (
+	// SQLModeAppend inserts rows into the table.
+	SQLModeAppend = "append"
+	// SQLModeReplace removes all rows from the table (or recreates it) before inserting.
+	SQLModeReplace = "replace"
+	// SQLModeUpsert inserts rows and updates existing ones with the same key columns.
+	SQLModeUpsert = "upsert"
+)
+
 type confSQL struct {
 	dfOptions    []Option
 	transformers map[string]transformerFunc
+	mode         string
+	createTable  bool
+	keyColumns   []string
+	batchSize    int
+	timeFormat   string
 }
 
 func combineSQLConfig(options ...ConfOption) confSQL {
 	conf := confSQL{
 		dfOptions:    []Option{},
 		transformers: DefaultTransformers(),
+		mode:         SQLModeAppend,
+		keyColumns:   []string{},
+		batchSize:    100,
+		timeFormat:   "2006-01-02 15:04:05",
 	}
 
 	for _, option := range options {
 		switch option.Key() {
 		case optionSQLDataframeOptions:
 			conf.dfOptions = option.Value().([]Option)
+		case optionSQLDataframeTransformers:
+			conf.transformers = option.Value().(map[string]transformerFunc)
+		case optionSQLMode:
+			conf.mode = option.Value().(string)
+		case optionSQLCreateTable:
+			conf.createTable = option.Value().(bool)
+		case optionSQLKeyColumns:
+			conf.keyColumns = option.Value().([]string)
+		case optionSQLBatchSize:
+			conf.batchSize = option.Value().(int)
+		case optionSQLTimeFormat:
+			conf.timeFormat = option.Value().(string)
 		}
 	}
 
 	return conf
 }
 
+// FromSQL loads the result of the query to a dataframe.
+//
+// Possible options are:
+//   - SQLOptionDataframeOptions(options ...Option) - options to pass to the new dataframe.
+//   - SQLOptionTransformers(transformers) - functions which transform loaded columns by their kind ("string",
+//     "boolean", "time", "decimal") or by the database type of string columns ("DATE", "DATETIME"). They replace
+//     DefaultTransformers() completely.
 func FromSQL(tx *sql.Tx, query string, args []interface{}, options ...ConfOption) (*Dataframe, error) {
 	conf := combineSQLConfig(options...)
 
@@ -67,7 +112,9 @@ func ReadSQLRows(rows *sql.Rows, conf confSQL) (*Dataframe, error) {
 
 	columns := make([]any, len(columnNames))
 	for i := 0; i < len(columnNames); i++ {
-		columns[i] = &SQLColumn{}
+		columns[i] = &SQLColumn{
+			decimal: isSQLDecimalType(columnTypes[i].DatabaseTypeName()),
+		}
 	}
 
 	for rows.Next() {
@@ -80,6 +127,19 @@ func ReadSQLRows(rows *sql.Rows, conf confSQL) (*Dataframe, error) {
 	vectors := make([]vector.Vector, len(columns))
 	for i, column := range columns {
 		col := column.(*SQLColumn)
+		if col.decimal {
+			vec := vector.StringWithNA(col.data.strings, col.data.na)
+			if !col.kindSet {
+				vec = vector.NA(col.nulls)
+			}
+			vec = vec.AsDecimal(vector.OptionDecimalScale(sqlDecimalScale(columnTypes[i], col)))
+			if transformer, ok := conf.transformers["decimal"]; ok {
+				vec = transformer(vec)
+			}
+			vectors[i] = vec
+			continue
+		}
+
 		switch col.kind {
 		case SQLBoolean:
 			vec := vector.BooleanWithNA(col.data.booleans, col.data.na)
@@ -129,6 +189,269 @@ func ReadSQLRows(rows *sql.Rows, conf confSQL) (*Dataframe, error) {
 	return df, nil
 }
 
+// ToSQL writes the dataframe to the table. Rows are inserted in batches with prepared statements. NA-values are
+// written as NULL, time values - as strings in the time format. Identifiers are quoted with double quotes and
+// parameters use "?" placeholders, upserts use "ON CONFLICT ... DO UPDATE" clause (SQLite, PostgreSQL).
+//
+// Possible options are:
+//   - SQLOptionMode(mode string) - SQLModeAppend (default), SQLModeReplace or SQLModeUpsert.
+//   - SQLOptionCreateTable(create bool) - create the table if it does not exist. Column types are derived from
+//     payload types, key columns form the primary key.
+//   - SQLOptionKeyColumns(columns ...string) - key columns for upserts. If several rows have the same key, only
+//     the last one is written, because one statement can't update the same row twice (PostgreSQL).
+//   - SQLOptionBatchSize(size int) - number of rows inserted by one statement (100 by default).
+//   - SQLOptionTimeFormat(format string) - a layout for time values ("2006-01-02 15:04:05" by default).
+func (df *Dataframe) ToSQL(tx *sql.Tx, table string, options ...ConfOption) error {
+	conf := combineSQLConfig(options...)
+
+	for _, key := range conf.keyColumns {
+		if !df.HasColumn(key) {
+			return errors.New(fmt.Sprintf("key column %s does not exist", key))
+		}
+	}
+
+	if conf.mode == SQLModeUpsert && len(conf.keyColumns) == 0 {
+		return errors.New("key columns are required for upsert")
+	}
+
+	if conf.mode == SQLModeReplace {
+		query := "DELETE FROM " + quoteSQLIdentifier(table)
+		if conf.createTable {
+			query = "DROP TABLE IF EXISTS " + quoteSQLIdentifier(table)
+		}
+
+		if _, err := tx.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	if conf.createTable {
+		if _, err := tx.Exec(df.createTableQuery(table, conf)); err != nil {
+			return err
+		}
+	}
+
+	if df.rowNum == 0 || df.colNum == 0 {
+		return nil
+	}
+
+	batchSize := conf.batchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	columns := make([][]any, df.colNum)
+	for i, column := range df.columns {
+		columns[i] = sqlColumnValues(column, conf.timeFormat)
+	}
+
+	rows := make([]int, df.rowNum)
+	for i := range rows {
+		rows[i] = i
+	}
+	if conf.mode == SQLModeUpsert {
+		rows = df.lastRowsByKeys(conf.keyColumns)
+	}
+
+	var stmt *sql.Stmt
+	stmtRows := 0
+	defer func() {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}()
+
+	for from := 0; from < len(rows); from += batchSize {
+		to := from + batchSize
+		if to > len(rows) {
+			to = len(rows)
+		}
+
+		if stmt == nil || stmtRows != to-from {
+			if stmt != nil {
+				stmt.Close()
+			}
+
+			var err error
+			stmt, err = tx.Prepare(df.insertQuery(table, to-from, conf))
+			if err != nil {
+				stmt = nil
+				return err
+			}
+			stmtRows = to - from
+		}
+
+		args := make([]any, 0, (to-from)*df.colNum)
+		for _, row := range rows[from:to] {
+			for col := 0; col < df.colNum; col++ {
+				args = append(args, columns[col][row])
+			}
+		}
+
+		if _, err := stmt.Exec(args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lastRowsByKeys returns zero-based positions of the last rows for every combination of key values.
+func (df *Dataframe) lastRowsByKeys(keyColumns []string) []int {
+	groups := df.rowGroups(keyColumns)
+
+	rows := make([]int, len(groups))
+	for i, group := range groups {
+		rows[i] = group[len(group)-1] - 1
+	}
+	sort.Ints(rows)
+
+	return rows
+}
+
+func (df *Dataframe) createTableQuery(table string, conf confSQL) string {
+	query := "CREATE TABLE IF NOT EXISTS " + quoteSQLIdentifier(table) + " ("
+
+	for i, name := range df.columnNames {
+		if i > 0 {
+			query += ", "
+		}
+		query += quoteSQLIdentifier(name) + " " + sqlColumnType(df.columns[i])
+	}
+
+	if len(conf.keyColumns) > 0 {
+		query += ", PRIMARY KEY (" + quoteSQLIdentifiers(conf.keyColumns) + ")"
+	}
+
+	return query + ")"
+}
+
+func (df *Dataframe) insertQuery(table string, rows int, conf confSQL) string {
+	placeholders := "("
+	for i := 0; i < df.colNum; i++ {
+		if i > 0 {
+			placeholders += ", "
+		}
+		placeholders += "?"
+	}
+	placeholders += ")"
+
+	query := "INSERT INTO " + quoteSQLIdentifier(table) + " (" + quoteSQLIdentifiers(df.columnNames) + ") VALUES "
+	for i := 0; i < rows; i++ {
+		if i > 0 {
+			query += ", "
+		}
+		query += placeholders
+	}
+
+	if conf.mode == SQLModeUpsert {
+		updates := ""
+		for _, name := range df.columnNames {
+			if strPosInSlice(conf.keyColumns, name) != -1 {
+				continue
+			}
+
+			if updates != "" {
+				updates += ", "
+			}
+			updates += quoteSQLIdentifier(name) + " = excluded." + quoteSQLIdentifier(name)
+		}
+
+		query += " ON CONFLICT (" + quoteSQLIdentifiers(conf.keyColumns) + ")"
+		if updates == "" {
+			query += " DO NOTHING"
+		} else {
+			query += " DO UPDATE SET " + updates
+		}
+	}
+
+	return query
+}
+
+func sqlColumnType(vec vector.Vector) string {
+	switch vec.Type() {
+	case "integer", "int32", "int64", "uint8":
+		return "INTEGER"
+	case "float", "float32":
+		return "REAL"
+	case "boolean":
+		return "BOOLEAN"
+	case "time":
+		return SQLTypeDateTime
+	case "date":
+		return SQLTypeDate
+	case "decimal":
+		return "DECIMAL"
+	}
+
+	return "TEXT"
+}
+
+func sqlColumnValues(vec vector.Vector, timeFormat string) []any {
+	isNA := vec.IsNA()
+	values := make([]any, vec.Len())
+
+	switch vec.Type() {
+	case "integer", "int32", "int64", "uint8":
+		data, _ := vec.Integers()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "float", "float32":
+		data, _ := vec.Floats()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "boolean":
+		data, _ := vec.Booleans()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "string", "decimal":
+		data, _ := vec.Strings()
+		for i := range values {
+			values[i] = data[i]
+		}
+	case "time":
+		data, _ := vec.Times()
+		for i := range values {
+			values[i] = data[i].Format(timeFormat)
+		}
+	case "date":
+		data, _ := vec.Dates()
+		for i := range values {
+			values[i] = data[i].Format(vector.DefaultDateFormat)
+		}
+	default:
+		for i := range values {
+			values[i] = vec.StrForElem(i + 1)
+		}
+	}
+
+	for i := range values {
+		if isNA[i] {
+			values[i] = nil
+		}
+	}
+
+	return values
+}
+
+func quoteSQLIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteSQLIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteSQLIdentifier(name)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// DefaultTransformers returns transformers used by FromSQL by default. DATETIME and DATE columns, which are read
+// as strings, become time vectors.
 func DefaultTransformers() map[string]transformerFunc {
 	return map[string]func(vector.Vector) vector.Vector{
 		"DATETIME": func(vec vector.Vector) vector.Vector {
@@ -142,6 +465,18 @@ func DefaultTransformers() map[string]transformerFunc {
 	}
 }
 
+// DateTransformers returns default transformers where DATE columns are loaded as date vectors instead of time
+// ones. Use it with SQLOptionTransformers(DateTransformers()).
+func DateTransformers() map[string]transformerFunc {
+	transformers := DefaultTransformers()
+	transformers["DATE"] = func(vec vector.Vector) vector.Vector {
+		vec.SetOption(vector.OptionDateFormat("2006-01-02"))
+		return vec.AsDate()
+	}
+
+	return transformers
+}
+
 type SQLColumnType int
 
 const (
@@ -157,6 +492,7 @@ type SQLColumn struct {
 	kind    SQLColumnType
 	kindSet bool
 	nulls   int
+	decimal bool
 	data    struct {
 		booleans []bool
 		floats   []float64
@@ -271,7 +607,7 @@ func (c *SQLColumn) Null() error {
 		c.data.floats = append(c.data.floats, math.NaN())
 		c.data.na = append(c.data.na, true)
 	case SQLInteger:
-		c.data.floats = append(c.data.floats, 0)
+		c.data.integers = append(c.data.integers, 0)
 		c.data.na = append(c.data.na, true)
 	case SQLString:
 		c.data.strings = append(c.data.strings, "")
@@ -286,7 +622,20 @@ func (c *SQLColumn) Null() error {
 	return nil
 }
 
+// Scan stores a value of the column. Values of DECIMAL and NUMERIC columns are kept as strings, so they can be
+// converted to decimals exactly. Floats are formatted with the shortest representation which gives the same float.
 func (c *SQLColumn) Scan(val interface{}) error {
+	if c.decimal {
+		switch v := val.(type) {
+		case float64:
+			c.String(strconv.FormatFloat(v, 'f', -1, 64))
+			return nil
+		case int64:
+			c.String(strconv.FormatInt(v, 10))
+			return nil
+		}
+	}
+
 	switch v := val.(type) {
 	case bool:
 		c.Boolean(v)
@@ -312,6 +661,51 @@ func (c *SQLColumn) Scan(val interface{}) error {
 	return nil
 }
 
+func isSQLDecimalType(typeName string) bool {
+	typeName = strings.ToUpper(strings.TrimSpace(typeName))
+
+	return strings.HasPrefix(typeName, "DECIMAL") || strings.HasPrefix(typeName, "NUMERIC")
+}
+
+// sqlDecimalScale returns the scale of a DECIMAL or NUMERIC column. It is taken from the driver, then from the
+// declared type like "DECIMAL(10,2)" and at last it is the biggest number of digits after the decimal point in the
+// column values.
+func sqlDecimalScale(columnType *sql.ColumnType, col *SQLColumn) int {
+	if _, scale, ok := columnType.DecimalSize(); ok {
+		return int(scale)
+	}
+
+	typeName := columnType.DatabaseTypeName()
+	if open, end := strings.Index(typeName, "("), strings.Index(typeName, ")"); open != -1 && end > open {
+		params := strings.Split(typeName[open+1:end], ",")
+		if len(params) == 2 {
+			if scale, err := strconv.Atoi(strings.TrimSpace(params[1])); err == nil {
+				return scale
+			}
+		}
+		if len(params) == 1 {
+			return 0
+		}
+	}
+
+	scale := 0
+	for i, str := range col.data.strings {
+		if col.data.na[i] {
+			continue
+		}
+
+		str = strings.ToLower(strings.TrimSpace(str))
+		if strings.Contains(str, "e") {
+			continue
+		}
+		if point := strings.Index(str, "."); point != -1 && len(str)-point-1 > scale {
+			scale = len(str) - point - 1
+		}
+	}
+
+	return scale
+}
+
 func SQLOptionDataframeOptions(options ...Option) ConfOption {
 	return ConfOption{optionSQLDataframeOptions, options}
 }
@@ -319,3 +713,23 @@ func SQLOptionDataframeOptions(options ...Option) ConfOption {
 func SQLOptionTransformers(transformers map[string]transformerFunc) ConfOption {
 	return ConfOption{optionSQLDataframeTransformers, transformers}
 }
+
+func SQLOptionMode(mode string) ConfOption {
+	return ConfOption{optionSQLMode, mode}
+}
+
+func SQLOptionCreateTable(create bool) ConfOption {
+	return ConfOption{optionSQLCreateTable, create}
+}
+
+func SQLOptionKeyColumns(columns ...string) ConfOption {
+	return ConfOption{optionSQLKeyColumns, columns}
+}
+
+func SQLOptionBatchSize(size int) ConfOption {
+	return ConfOption{optionSQLBatchSize, size}
+}
+
+func SQLOptionTimeFormat(format string) ConfOption {
+	return ConfOption{optionSQLTimeFormat, format}
+}
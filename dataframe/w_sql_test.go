@@ -7,6 +7,7 @@ import (
 	"logarithmotechnia/vector"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestFromSQL(t *testing.T) {
@@ -58,6 +59,31 @@ func TestSQLOptions(t *testing.T) {
 			reference: ConfOption{optionSQLDataframeOptions,
 				[]Option{OptionColumnNames([]string{"id", "price"})}},
 		},
+		{
+			name:      "SQLOptionMode",
+			result:    SQLOptionMode(SQLModeUpsert),
+			reference: ConfOption{optionSQLMode, SQLModeUpsert},
+		},
+		{
+			name:      "SQLOptionCreateTable",
+			result:    SQLOptionCreateTable(true),
+			reference: ConfOption{optionSQLCreateTable, true},
+		},
+		{
+			name:      "SQLOptionKeyColumns",
+			result:    SQLOptionKeyColumns("id", "vendor_id"),
+			reference: ConfOption{optionSQLKeyColumns, []string{"id", "vendor_id"}},
+		},
+		{
+			name:      "SQLOptionBatchSize",
+			result:    SQLOptionBatchSize(500),
+			reference: ConfOption{optionSQLBatchSize, 500},
+		},
+		{
+			name:      "SQLOptionTimeFormat",
+			result:    SQLOptionTimeFormat("2006-01-02"),
+			reference: ConfOption{optionSQLTimeFormat, "2006-01-02"},
+		},
 	}
 
 	for _, data := range testData {
@@ -82,3 +108,281 @@ func TestSQLOptionTransformers(t *testing.T) {
 		t.Error("SQLOptionTransformers() failed")
 	}
 }
+
+func TestFromSQL_Transformers(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`CREATE TABLE "codes" (code TEXT)`)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = tx.Exec(`INSERT INTO "codes" VALUES ('1'), ('2')`)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	df, err := FromSQL(tx, `SELECT * FROM "codes"`, []any{}, SQLOptionTransformers(map[string]transformerFunc{
+		"string": func(vec vector.Vector) vector.Vector {
+			return vec.AsInteger()
+		},
+	}))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := vector.Integer([]int{1, 2})
+	if !vector.CompareVectorsForTest(df.Cn("code"), expected) {
+		t.Error(fmt.Sprintf("Column (%v) is not equal to expected (%v)", df.Cn("code"), expected))
+	}
+}
+
+func TestDataframe_ToSQL(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer tx.Rollback()
+
+	df := New([]Column{
+		{"id", vector.Integer([]int{1, 2, 3})},
+		{"title", vector.StringWithNA([]string{"Item 1", "", "Item 3"}, []bool{false, true, false})},
+		{"qty", vector.IntegerWithNA([]int{10, 0, 30}, []bool{false, true, false})},
+		{"price", vector.FloatWithNA([]float64{1.5, 2.25, 0}, []bool{false, false, true})},
+		{"created", vector.Time([]time.Time{
+			time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 2, 11, 30, 0, 0, time.UTC),
+			time.Date(2022, 1, 3, 12, 0, 15, 0, time.UTC),
+		})},
+	})
+
+	testData := []struct {
+		name    string
+		df      *Dataframe
+		options []ConfOption
+		columns []vector.Vector
+	}{
+		{
+			name: "create and append",
+			df:   df,
+			options: []ConfOption{
+				SQLOptionCreateTable(true),
+				SQLOptionKeyColumns("id"),
+				SQLOptionBatchSize(2),
+			},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3}),
+				vector.StringWithNA([]string{"Item 1", "", "Item 3"}, []bool{false, true, false}),
+				vector.IntegerWithNA([]int{10, 0, 30}, []bool{false, true, false}),
+				vector.FloatWithNA([]float64{1.5, 2.25, 0}, []bool{false, false, true}),
+				vector.Time([]time.Time{
+					time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2022, 1, 2, 11, 30, 0, 0, time.UTC),
+					time.Date(2022, 1, 3, 12, 0, 15, 0, time.UTC),
+				}),
+			},
+		},
+		{
+			name: "upsert",
+			df:   df.ByIndices([]int{2, 3}).Mutate(Column{"id", vector.Integer([]int{2, 4})}),
+			options: []ConfOption{
+				SQLOptionMode(SQLModeUpsert),
+				SQLOptionKeyColumns("id"),
+			},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3, 4}),
+				vector.StringWithNA([]string{"Item 1", "", "Item 3", "Item 3"}, []bool{false, true, false, false}),
+				vector.IntegerWithNA([]int{10, 0, 30, 30}, []bool{false, true, false, false}),
+				vector.FloatWithNA([]float64{1.5, 2.25, 0, 0}, []bool{false, false, true, true}),
+				vector.Time([]time.Time{
+					time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2022, 1, 2, 11, 30, 0, 0, time.UTC),
+					time.Date(2022, 1, 3, 12, 0, 15, 0, time.UTC),
+					time.Date(2022, 1, 3, 12, 0, 15, 0, time.UTC),
+				}),
+			},
+		},
+		{
+			name: "upsert with duplicate keys",
+			df:   df.ByIndices([]int{3, 1}).Mutate(Column{"id", vector.Integer([]int{4, 4})}),
+			options: []ConfOption{
+				SQLOptionMode(SQLModeUpsert),
+				SQLOptionKeyColumns("id"),
+			},
+			columns: []vector.Vector{
+				vector.Integer([]int{1, 2, 3, 4}),
+				vector.StringWithNA([]string{"Item 1", "", "Item 3", "Item 1"}, []bool{false, true, false, false}),
+				vector.IntegerWithNA([]int{10, 0, 30, 10}, []bool{false, true, false, false}),
+				vector.FloatWithNA([]float64{1.5, 2.25, 0, 1.5}, []bool{false, false, true, false}),
+				vector.Time([]time.Time{
+					time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2022, 1, 2, 11, 30, 0, 0, time.UTC),
+					time.Date(2022, 1, 3, 12, 0, 15, 0, time.UTC),
+					time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+				}),
+			},
+		},
+		{
+			name:    "replace",
+			df:      df.ByIndices([]int{1}),
+			options: []ConfOption{SQLOptionMode(SQLModeReplace)},
+			columns: []vector.Vector{
+				vector.Integer([]int{1}),
+				vector.String([]string{"Item 1"}),
+				vector.Integer([]int{10}),
+				vector.Float([]float64{1.5}),
+				vector.Time([]time.Time{time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)}),
+			},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			err := data.df.ToSQL(tx, "items", data.options...)
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			newDf, err := FromSQL(tx, "SELECT * FROM items ORDER BY id", []any{})
+			if err != nil {
+				t.Error(fmt.Sprintf("Unexpected error: %v", err))
+				return
+			}
+
+			if !vector.CompareVectorArrs(newDf.columns, data.columns) {
+				t.Error(fmt.Sprintf("Columns (%v) are not equal to expected (%v)", newDf.columns, data.columns))
+			}
+
+			if !reflect.DeepEqual(newDf.columnNames, df.columnNames) {
+				t.Error(fmt.Sprintf("Column names (%v) are not equal to expected (%v)",
+					newDf.columnNames, df.columnNames))
+			}
+		})
+	}
+
+	err = df.ToSQL(tx, "items", SQLOptionMode(SQLModeUpsert))
+	if err == nil {
+		t.Error("Error is expected for upsert without key columns")
+	}
+
+	err = df.ToSQL(tx, "items", SQLOptionKeyColumns("unknown"))
+	if err == nil {
+		t.Error("Error is expected for a non-existent key column")
+	}
+}
+
+func TestFromSQL_Decimal(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`CREATE TABLE "prices" (amount DECIMAL(10,2), rate numeric(5, 3), total NUMERIC, empty NUMERIC)`)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = tx.Exec(`INSERT INTO "prices" VALUES (10.1, '1.125', 3, NULL), (NULL, 2, 249.99, NULL)`)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	df, err := FromSQL(tx, `SELECT * FROM "prices"`, []any{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expectedColumns := []vector.Vector{
+		vector.DecimalWithNA([]int64{1010, 0}, []bool{false, true}),
+		vector.Decimal([]int64{1125, 2000}, vector.OptionDecimalScale(3)),
+		vector.Decimal([]int64{300, 24999}),
+		vector.DecimalWithNA([]int64{0, 0}, []bool{true, true}, vector.OptionDecimalScale(0)),
+	}
+
+	for i, column := range df.columns {
+		if !vector.CompareVectorsForTest(column, expectedColumns[i]) {
+			t.Error(fmt.Sprintf("Column %d (%v) is not equal to expected (%v)", i, column, expectedColumns[i]))
+		}
+	}
+
+	err = df.ToSQL(tx, "prices_copy", SQLOptionCreateTable(true))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	copied, err := FromSQL(tx, `SELECT * FROM "prices_copy"`, []any{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	amounts, _ := copied.Cn("amount").Strings()
+	if !reflect.DeepEqual(amounts, []string{"10.1", ""}) {
+		t.Error(fmt.Sprintf("Copied amounts (%v) are not equal to expected", amounts))
+	}
+}
+
+func TestDateTransformers(t *testing.T) {
+	day := time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)
+	testData := []struct {
+		name         string
+		transformers map[string]transformerFunc
+		expected     vector.Vector
+	}{
+		{
+			name:         "default",
+			transformers: DefaultTransformers(),
+			expected: vector.TimeWithNA([]time.Time{day, {}}, []bool{false, true},
+				vector.OptionTimeFormat("2006-01-02")),
+		},
+		{
+			name:         "date",
+			transformers: DateTransformers(),
+			expected:     vector.DateWithNA([]time.Time{day, {}}, []bool{false, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			vec := data.transformers[SQLTypeDate](vector.StringWithNA([]string{"2022-03-15", ""}, []bool{false, true}))
+			if !vector.CompareVectorsForTest(vec, data.expected) {
+				t.Error(fmt.Sprintf("Vector (%v) is not equal to expected (%v)", vec, data.expected))
+			}
+		})
+	}
+}
@@ -115,6 +115,14 @@ func dataToVectors(data map[string][]any, types map[string]string, order []strin
 			columns[i] = Column{field, vector.Integer(anyArrToTyped[int](data[field]))}
 		case "float":
 			columns[i] = Column{field, vector.Float(anyArrToTyped[float64](data[field]))}
+		case "int32":
+			columns[i] = Column{field, vector.Int32(anyArrToTyped[int32](data[field]))}
+		case "int64":
+			columns[i] = Column{field, vector.Int64(anyArrToTyped[int64](data[field]))}
+		case "uint8":
+			columns[i] = Column{field, vector.Uint8(anyArrToTyped[uint8](data[field]))}
+		case "float32":
+			columns[i] = Column{field, vector.Float32(anyArrToTyped[float32](data[field]))}
 		case "complex":
 			columns[i] = Column{field, vector.Complex(anyArrToTyped[complex128](data[field]))}
 		case "string":
@@ -123,6 +131,8 @@ func dataToVectors(data map[string][]any, types map[string]string, order []strin
 			columns[i] = Column{field, vector.Boolean(anyArrToTyped[bool](data[field]))}
 		case "time":
 			columns[i] = Column{field, vector.Time(anyArrToTyped[time.Time](data[field]))}
+		case "duration":
+			columns[i] = Column{field, vector.Duration(anyArrToTyped[time.Duration](data[field]))}
 		case "any":
 			columns[i] = Column{field, vector.Any(data[field])}
 		}
@@ -139,6 +149,18 @@ func getFieldType(fVal reflect.Value) string {
 		t = "integer"
 	case reflect.Float64:
 		t = "float"
+	case reflect.Int32:
+		t = "int32"
+	case reflect.Int64:
+		if _, ok := fVal.Interface().(time.Duration); ok {
+			t = "duration"
+		} else {
+			t = "int64"
+		}
+	case reflect.Uint8:
+		t = "uint8"
+	case reflect.Float32:
+		t = "float32"
 	case reflect.Complex128:
 		t = "complex"
 	case reflect.String:
@@ -24,6 +24,11 @@ func TestFromStructs(t *testing.T) {
 		Date      time.Time
 		OldDate   time.Time
 		Misc      Finance
+		Sensor    int32
+		Counter   int64
+		Flags     uint8
+		Reading   float32
+		Uptime    time.Duration
 	}
 
 	now := time.Now()
@@ -37,6 +42,11 @@ func TestFromStructs(t *testing.T) {
 			IsActive: true,
 			Date:     now,
 			Misc:     Finance{1000, "br"},
+			Sensor:   10,
+			Counter:  1 << 40,
+			Flags:    255,
+			Reading:  0.5,
+			Uptime:   time.Second,
 		},
 		{
 			Title:    "Earl",
@@ -64,7 +74,8 @@ func TestFromStructs(t *testing.T) {
 		t.Error(err)
 	}
 
-	columnNames := []string{"Title", "status", "Kpi", "Cpx", "is_active", "date", "Misc"}
+	columnNames := []string{"Title", "status", "Kpi", "Cpx", "is_active", "date", "Misc", "Sensor", "Counter",
+		"Flags", "Reading", "Uptime"}
 	columns := []vector.Vector{
 		vector.String([]string{"Baron", "Earl", "King"}),
 		vector.Integer([]int{1, 3, 5}),
@@ -74,6 +85,11 @@ func TestFromStructs(t *testing.T) {
 		vector.Time([]time.Time{now, now.Add(7 * 24 * 60 * time.Minute), now.Add(360 * 24 * 60 * time.Minute)}),
 		vector.Any([]any{Finance{1000, "br"}, Finance{15000, "ct"},
 			Finance{275000, "kn"}}),
+		vector.Int32([]int32{10, 0, 0}),
+		vector.Int64([]int64{1 << 40, 0, 0}),
+		vector.Uint8([]uint8{255, 0, 0}),
+		vector.Float32([]float32{0.5, 0, 0}),
+		vector.Duration([]time.Duration{time.Second, 0, 0}),
 	}
 
 	if !reflect.DeepEqual(df.columnNames, columnNames) {
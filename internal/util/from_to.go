@@ -25,6 +25,21 @@ func FromTo(from, to, length int) []int {
 	return indices
 }
 
+// FromToRange returns bounds of a from-to selection (starting from 1, inclusive) if the selection is a contiguous
+// range of elements in the ascending order.
+func FromToRange(from, to, length int) (int, int, bool) {
+	if from < 0 || to <= 0 || from > to {
+		return 0, 0, false
+	}
+
+	from, to = normalizeFromTo(from, to, length)
+	if from > to {
+		return 0, 0, false
+	}
+
+	return from, to, true
+}
+
 func byFromToRegular(from, to, length int) []int {
 	from, to = normalizeFromTo(from, to, length)
 
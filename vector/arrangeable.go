@@ -27,15 +27,15 @@ func (ar *DefArrangeable) sortedIndices() []int {
 	var fn func(i, j int) bool
 	if ar.HasNA() {
 		fn = func(i, j int) bool {
-			if ar.na[indices[i]] && ar.na[indices[j]] {
+			if ar.na.at(indices[i]) && ar.na.at(indices[j]) {
 				return i < j
 			}
 
-			if ar.na[indices[i]] {
+			if ar.na.at(indices[i]) {
 				return false
 			}
 
-			if ar.na[indices[j]] {
+			if ar.na.at(indices[j]) {
 				return true
 			}
 
@@ -64,17 +64,17 @@ func (ar *DefArrangeable) SortedIndicesWithRanks() ([]int, []int) {
 	}
 
 	if len(indices) == 1 {
-		return indices, []int{1}
+		return incIndices(indices), []int{1}
 	}
 
 	rank := 1
 	ranks := make([]int, ar.Length)
-	if ar.na[0] {
+	if ar.na.at(indices[0]) {
 		rank = 0
 	}
 	ranks[0] = rank
 	for i := 1; i < ar.Length; i++ {
-		if ar.na[indices[i]] != ar.na[indices[i-1]] || !ar.FnEqual(indices[i], indices[i-1]) {
+		if ar.na.at(indices[i]) != ar.na.at(indices[i-1]) || !ar.FnEqual(indices[i], indices[i-1]) {
 			rank++
 			ranks[i] = rank
 		} else {
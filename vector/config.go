@@ -3,6 +3,7 @@ package vector
 const keyOptionPrecision = "precision"
 const keyOptionFormat = "format"
 const keyOptionTimeFormat = "time_format"
+const keyOptionDateFormat = "date_format"
 const keyOptionStringToBooleanConverter = "string_boolean_converter"
 const keyOptionAnyPrinterFunc = "any_printer_func"
 const keyOptionAnyConvertors = "any_convertors"
@@ -10,6 +11,14 @@ const keyOptionAnyCallbacks = "any_callbacks"
 const keyOptionGroupIndex = "group_index"
 const keyOptionVectorName = "vector_name"
 const keyOptionMaxPrintElements = "max_print_elements"
+const keyOptionRollingAlign = "rolling_align"
+const keyOptionRollingMinPeriods = "rolling_min_periods"
+const keyOptionNARemove = "na_remove"
+const keyOptionFactorLevels = "factor_levels"
+const keyOptionFactorOrdered = "factor_ordered"
+const keyOptionDecimalScale = "decimal_scale"
+const keyOptionDecimalRounding = "decimal_rounding"
+const keyOptionInterpolationTimes = "interpolation_times"
 
 // deprecated
 type Config struct {
@@ -82,6 +91,10 @@ func OptionTimeFormat(format string) Option {
 	return ConfOption{keyOptionTimeFormat, format}
 }
 
+func OptionDateFormat(format string) Option {
+	return ConfOption{keyOptionDateFormat, format}
+}
+
 func OptionAnyPrinterFunc(fn AnyPrinterFunc) Option {
 	return ConfOption{keyOptionAnyPrinterFunc, fn}
 }
@@ -109,3 +122,35 @@ func OptionMaxPrintElements(maxPrintEleements int) Option {
 func OptionStringToBooleanConverter(converter StringToBooleanConverter) Option {
 	return ConfOption{keyOptionStringToBooleanConverter, converter}
 }
+
+func OptionRollingAlign(align string) Option {
+	return ConfOption{keyOptionRollingAlign, align}
+}
+
+func OptionRollingMinPeriods(minPeriods int) Option {
+	return ConfOption{keyOptionRollingMinPeriods, minPeriods}
+}
+
+func OptionNARemove(remove bool) Option {
+	return ConfOption{keyOptionNARemove, remove}
+}
+
+func OptionLevels(levels ...string) Option {
+	return ConfOption{keyOptionFactorLevels, levels}
+}
+
+func OptionFactorOrdered(ordered bool) Option {
+	return ConfOption{keyOptionFactorOrdered, ordered}
+}
+
+func OptionDecimalScale(scale int) Option {
+	return ConfOption{keyOptionDecimalScale, scale}
+}
+
+func OptionDecimalRounding(rounding string) Option {
+	return ConfOption{keyOptionDecimalRounding, rounding}
+}
+
+func OptionInterpolationTimes(times Vector) Option {
+	return ConfOption{keyOptionInterpolationTimes, times}
+}
@@ -0,0 +1,166 @@
+package vector
+
+import (
+	"math"
+)
+
+const (
+	CorPearson  = "pearson"
+	CorSpearman = "spearman"
+	CorKendall  = "kendall"
+)
+
+// Cor returns a float vector with the single value of correlation between two numeric vectors. Possible methods are
+// CorPearson, CorSpearman and CorKendall (tau-b). Only pairs where both values are not NA are used. NA is returned
+// if vectors are not numeric, have different lengths, the method is unknown or the correlation is undefined.
+func Cor(a, b Vector, method string) Vector {
+	x, y, ok := completePairs(a, b)
+	if !ok {
+		return FloatWithNA([]float64{0}, []bool{true})
+	}
+
+	var cor float64
+	switch method {
+	case CorPearson:
+		cor, ok = pearson(x, y)
+	case CorSpearman:
+		cor, ok = pearson(averageRanks(x), averageRanks(y))
+	case CorKendall:
+		cor, ok = kendall(x, y)
+	default:
+		ok = false
+	}
+
+	return FloatWithNA([]float64{cor}, []bool{!ok})
+}
+
+// Cov returns a float vector with the single value of sample covariance between two numeric vectors. Only pairs
+// where both values are not NA are used. NA is returned if vectors are not numeric, have different lengths or there
+// are less than two complete pairs.
+func Cov(a, b Vector) Vector {
+	x, y, ok := completePairs(a, b)
+	if !ok || len(x) < 2 {
+		return FloatWithNA([]float64{0}, []bool{true})
+	}
+
+	return Float([]float64{covariance(x, y)})
+}
+
+// IsNumeric returns true if the vector contains integer, float (of any width) or decimal values.
+func IsNumeric(v Vector) bool {
+	switch v.Type() {
+	case PayloadTypeInteger, PayloadTypeInt32, PayloadTypeInt64, PayloadTypeUint8,
+		PayloadTypeFloat, PayloadTypeFloat32, PayloadTypeDecimal:
+		return true
+	}
+
+	return false
+}
+
+// completePairs returns values of both vectors for positions where none of them is NA.
+func completePairs(a, b Vector) ([]float64, []float64, bool) {
+	if a == nil || b == nil || a.Len() != b.Len() || !IsNumeric(a) || !IsNumeric(b) {
+		return nil, nil, false
+	}
+
+	aData, aNA := a.Floats()
+	bData, bNA := b.Floats()
+
+	x := make([]float64, 0, len(aData))
+	y := make([]float64, 0, len(bData))
+	for i := range aData {
+		if aNA[i] || bNA[i] {
+			continue
+		}
+
+		x = append(x, aData[i])
+		y = append(y, bData[i])
+	}
+
+	return x, y, true
+}
+
+func covariance(x, y []float64) float64 {
+	meanX, _ := genMean(x, nil)
+	meanY, _ := genMean(y, nil)
+
+	var sum float64
+	for i := range x {
+		sum += (x[i] - meanX) * (y[i] - meanY)
+	}
+
+	return sum / float64(len(x)-1)
+}
+
+func pearson(x, y []float64) (float64, bool) {
+	if len(x) < 2 {
+		return 0, false
+	}
+
+	sdX, _ := genSd(x, nil)
+	sdY, _ := genSd(y, nil)
+	if sdX == 0 || sdY == 0 {
+		return 0, false
+	}
+
+	return covariance(x, y) / (sdX * sdY), true
+}
+
+func kendall(x, y []float64) (float64, bool) {
+	length := len(x)
+	if length < 2 {
+		return 0, false
+	}
+
+	var concordant, discordant, tiesX, tiesY float64
+	for i := 0; i < length; i++ {
+		for j := i + 1; j < length; j++ {
+			dx := x[i] - x[j]
+			dy := y[i] - y[j]
+
+			switch {
+			case dx == 0 && dy == 0:
+				tiesX++
+				tiesY++
+			case dx == 0:
+				tiesX++
+			case dy == 0:
+				tiesY++
+			case dx*dy > 0:
+				concordant++
+			default:
+				discordant++
+			}
+		}
+	}
+
+	pairs := float64(length*(length-1)) / 2
+	denominator := math.Sqrt((pairs - tiesX) * (pairs - tiesY))
+	if denominator == 0 {
+		return 0, false
+	}
+
+	return (concordant - discordant) / denominator, true
+}
+
+// averageRanks returns ranks of the values where tied values get the average of their positions.
+func averageRanks(data []float64) []float64 {
+	indices, ranks := Float(data).SortedIndicesWithRanks()
+
+	avgRanks := make([]float64, len(data))
+	for from := 0; from < len(indices); {
+		to := from
+		for to+1 < len(indices) && ranks[to+1] == ranks[from] {
+			to++
+		}
+
+		avgRank := float64(from+to)/2 + 1
+		for i := from; i <= to; i++ {
+			avgRanks[indices[i]-1] = avgRank
+		}
+
+		from = to + 1
+	}
+
+	return avgRanks
+}
@@ -0,0 +1,182 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCor(t *testing.T) {
+	testData := []struct {
+		name     string
+		a        Vector
+		b        Vector
+		method   string
+		expected float64
+		isNA     bool
+	}{
+		{
+			name:     "pearson",
+			a:        Integer([]int{1, 2, 3, 4, 5}),
+			b:        Float([]float64{2, 4, 6, 8, 10}),
+			method:   CorPearson,
+			expected: 1,
+		},
+		{
+			name:     "pearson negative",
+			a:        Integer([]int{1, 2, 3, 4, 5}),
+			b:        Integer([]int{5, 4, 3, 2, 1}),
+			method:   CorPearson,
+			expected: -1,
+		},
+		{
+			name:     "pearson with na",
+			a:        IntegerWithNA([]int{1, 2, 0, 4}, []bool{false, false, true, false}),
+			b:        Integer([]int{2, 4, 100, 8}),
+			method:   CorPearson,
+			expected: 1,
+		},
+		{
+			name:     "spearman with ties",
+			a:        Integer([]int{1, 2, 2, 3}),
+			b:        Integer([]int{1, 3, 2, 4}),
+			method:   CorSpearman,
+			expected: 4.5 / math.Sqrt(22.5),
+		},
+		{
+			name:     "spearman monotonic",
+			a:        Float([]float64{1, 2, 3, 4}),
+			b:        Float([]float64{1, 10, 100, 1000}),
+			method:   CorSpearman,
+			expected: 1,
+		},
+		{
+			name:     "kendall",
+			a:        Integer([]int{1, 2, 3, 4}),
+			b:        Integer([]int{1, 3, 2, 4}),
+			method:   CorKendall,
+			expected: 4.0 / 6,
+		},
+		{
+			name:     "kendall with ties",
+			a:        Integer([]int{1, 2, 2, 3}),
+			b:        Integer([]int{1, 3, 2, 4}),
+			method:   CorKendall,
+			expected: 5 / math.Sqrt(30),
+		},
+		{
+			name:     "narrow types",
+			a:        Int32([]int32{1, 2, 3, 4}),
+			b:        Float32([]float32{2, 4, 6, 8}),
+			method:   CorPearson,
+			expected: 1,
+		},
+		{
+			name:   "constant",
+			a:      Integer([]int{1, 1, 1}),
+			b:      Integer([]int{1, 2, 3}),
+			method: CorPearson,
+			isNA:   true,
+		},
+		{
+			name:   "different lengths",
+			a:      Integer([]int{1, 2, 3}),
+			b:      Integer([]int{1, 2}),
+			method: CorPearson,
+			isNA:   true,
+		},
+		{
+			name:   "non-numeric",
+			a:      String([]string{"1", "2", "3"}),
+			b:      Integer([]int{1, 2, 3}),
+			method: CorPearson,
+			isNA:   true,
+		},
+		{
+			name:   "unknown method",
+			a:      Integer([]int{1, 2, 3}),
+			b:      Integer([]int{1, 2, 3}),
+			method: "unknown",
+			isNA:   true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			cor, na := Cor(data.a, data.b, data.method).Floats()
+
+			if na[0] != data.isNA {
+				t.Error(fmt.Sprintf("NA (%v) does not match expected (%v)", na[0], data.isNA))
+			}
+
+			if !data.isNA && math.Abs(cor[0]-data.expected) > 1e-9 {
+				t.Error(fmt.Sprintf("Correlation (%v) does not match expected (%v)", cor[0], data.expected))
+			}
+		})
+	}
+}
+
+func TestCov(t *testing.T) {
+	testData := []struct {
+		name     string
+		a        Vector
+		b        Vector
+		expected Vector
+	}{
+		{
+			name:     "regular",
+			a:        Integer([]int{1, 2, 3, 4}),
+			b:        Float([]float64{2, 4, 6, 8}),
+			expected: Float([]float64{10.0 / 3}),
+		},
+		{
+			name:     "with na",
+			a:        FloatWithNA([]float64{1, 2, 0, 3, 4}, []bool{false, false, true, false, false}),
+			b:        Float([]float64{2, 4, 100, 6, 8}),
+			expected: Float([]float64{10.0 / 3}),
+		},
+		{
+			name:     "one pair",
+			a:        Integer([]int{1}),
+			b:        Integer([]int{1}),
+			expected: FloatWithNA([]float64{0}, []bool{true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			cov := Cov(data.a, data.b)
+
+			if !CompareVectorsForTest(cov, data.expected) {
+				t.Error(fmt.Sprintf("Covariance (%v) does not match expected (%v)", cov, data.expected))
+			}
+		})
+	}
+}
+
+func TestIsNumeric(t *testing.T) {
+	testData := []struct {
+		name      string
+		vec       Vector
+		isNumeric bool
+	}{
+		{name: "integer", vec: Integer([]int{1}), isNumeric: true},
+		{name: "float", vec: Float([]float64{1}), isNumeric: true},
+		{name: "int32", vec: Int32([]int32{1}), isNumeric: true},
+		{name: "int64", vec: Int64([]int64{1}), isNumeric: true},
+		{name: "uint8", vec: Uint8([]uint8{1}), isNumeric: true},
+		{name: "float32", vec: Float32([]float32{1}), isNumeric: true},
+		{name: "decimal", vec: Decimal([]int64{1}), isNumeric: true},
+		{name: "string", vec: String([]string{"1"}), isNumeric: false},
+		{name: "boolean", vec: Boolean([]bool{true}), isNumeric: false},
+		{name: "complex", vec: Complex([]complex128{1}), isNumeric: false},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if IsNumeric(data.vec) != data.isNumeric {
+				t.Error(fmt.Sprintf("IsNumeric (%v) does not match expected (%v)", IsNumeric(data.vec), data.isNumeric))
+			}
+		})
+	}
+}
@@ -7,34 +7,39 @@ import (
 	"time"
 )
 
-func pickValueWithNA[T any](idx int, data []T, na []bool, maxLen int) any {
+func pickValueWithNA[T any](idx int, data []T, na naBitmap, maxLen int) any {
 	if idx < 1 || idx > maxLen {
 		return nil
 	}
 
-	if na[idx-1] {
+	if na.at(idx - 1) {
 		return nil
 	}
 
 	return any(data[idx-1])
 }
 
-func dataWithNAToInterfaceArray[T any](data []T, na []bool) []any {
+func dataWithNAToInterfaceArray[T any](data []T, na naBitmap) []any {
 	dataLen := len(data)
 	outData := make([]any, dataLen)
 
 	for idx, val := range data {
-		if na[idx] {
-			outData[idx] = nil
-		} else {
-			outData[idx] = val
-		}
+		outData[idx] = val
+	}
+	for _, idx := range na.indices(dataLen, true) {
+		outData[idx-1] = nil
 	}
 
 	return outData
 }
 
-func byIndicesWithNA[T any](indices []int, srcData []T, srcNA []bool, naDef T) ([]T, []bool) {
+// byIndicesWithNA returns data and NA-flags of elements with the indices. NA-flags are nil if the source has no
+// NA-values and there are no zero indices.
+func byIndicesWithNA[T any](indices []int, srcData []T, srcNA naBitmap, naDef T) ([]T, []bool) {
+	if srcNA == nil {
+		return byIndicesWithoutNA(indices, srcData, naDef), zeroIndicesNA(indices)
+	}
+
 	data := make([]T, 0, len(indices))
 	na := make([]bool, 0, len(indices))
 
@@ -44,13 +49,30 @@ func byIndicesWithNA[T any](indices []int, srcData []T, srcNA []bool, naDef T) (
 			na = append(na, true)
 		} else {
 			data = append(data, srcData[idx-1])
-			na = append(na, srcNA[idx-1])
+			na = append(na, srcNA.at(idx-1))
 		}
 	}
 
 	return data, na
 }
 
+// zeroIndicesNA returns NA-flags for zero indices or nil if there are no such indices.
+func zeroIndicesNA(indices []int) []bool {
+	var na []bool
+	for i, idx := range indices {
+		if idx != 0 {
+			continue
+		}
+
+		if na == nil {
+			na = make([]bool, len(indices))
+		}
+		na[i] = true
+	}
+
+	return na
+}
+
 func byIndicesWithoutNA[T any](indices []int, srcData []T, naDef T) []T {
 	data := make([]T, len(indices))
 
@@ -65,12 +87,16 @@ func byIndicesWithoutNA[T any](indices []int, srcData []T, naDef T) []T {
 	return data
 }
 
-func adjustToLesserSizeWithNA[T any](srcData []T, srcNA []bool, size int) ([]T, []bool) {
+func adjustToLesserSizeWithNA[T any](srcData []T, srcNA naBitmap, size int) ([]T, []bool) {
+	if srcNA == nil {
+		return adjustToLesserSizeWithoutNA(srcData, size), nil
+	}
+
 	data := make([]T, size)
 	na := make([]bool, size)
 
 	copy(data, srcData)
-	copy(na, srcNA)
+	srcNA.copyTo(na)
 
 	return data, na
 }
@@ -83,7 +109,11 @@ func adjustToLesserSizeWithoutNA[T any](srcData []T, size int) []T {
 	return data
 }
 
-func adjustToBiggerSizeWithNA[T any](srcData []T, srcNA []bool, length int, size int) ([]T, []bool) {
+func adjustToBiggerSizeWithNA[T any](srcData []T, srcNA naBitmap, length int, size int) ([]T, []bool) {
+	if srcNA == nil {
+		return adjustToBiggerSizeWithoutNA(srcData, length, size), nil
+	}
+
 	cycles := size / length
 	if size%length > 0 {
 		cycles++
@@ -94,7 +124,7 @@ func adjustToBiggerSizeWithNA[T any](srcData []T, srcNA []bool, length int, size
 
 	for i := 0; i < cycles; i++ {
 		copy(data[i*length:], srcData)
-		copy(na[i*length:], srcNA)
+		srcNA.copyTo(na[i*length : (i+1)*length])
 	}
 
 	data = data[:size]
@@ -148,7 +178,7 @@ func supportsWhicherWithoutNA[T any](whicher any) bool {
 	return false
 }
 
-func whichWithNA[T any](inData []T, inNA []bool, whicher any) []bool {
+func whichWithNA[T any](inData []T, inNA naBitmap, whicher any) []bool {
 	if byFunc, ok := whicher.(func(int, T, bool) bool); ok {
 		return selectByFuncWithNA(inData, inNA, byFunc)
 	}
@@ -158,6 +188,10 @@ func whichWithNA[T any](inData []T, inNA []bool, whicher any) []bool {
 	}
 
 	if byFunc, ok := whicher.(func(T) bool); ok {
+		if inNA == nil {
+			return selectByCompactFuncWithoutNA(inData, byFunc)
+		}
+
 		return selectByBriefFuncWithNA(inData, inNA, byFunc)
 	}
 
@@ -176,11 +210,11 @@ func whichWithoutNA[T any](inData []T, whicher any) []bool {
 	return make([]bool, len(inData))
 }
 
-func selectByFuncWithNA[T any](inData []T, inNA []bool, byFunc func(int, T, bool) bool) []bool {
+func selectByFuncWithNA[T any](inData []T, inNA naBitmap, byFunc func(int, T, bool) bool) []bool {
 	booleans := make([]bool, len(inData))
 
 	for idx, val := range inData {
-		booleans[idx] = byFunc(idx+1, val, inNA[idx])
+		booleans[idx] = byFunc(idx+1, val, inNA.at(idx))
 	}
 
 	return booleans
@@ -196,11 +230,11 @@ func selectByFuncWithoutNA[T any](inData []T, byFunc func(int, T) bool) []bool {
 	return booleans
 }
 
-func selectByCompactFuncWithNA[T any](inData []T, inNA []bool, byFunc func(T, bool) bool) []bool {
+func selectByCompactFuncWithNA[T any](inData []T, inNA naBitmap, byFunc func(T, bool) bool) []bool {
 	booleans := make([]bool, len(inData))
 
 	for idx, val := range inData {
-		booleans[idx] = byFunc(val, inNA[idx])
+		booleans[idx] = byFunc(val, inNA.at(idx))
 	}
 
 	return booleans
@@ -216,11 +250,11 @@ func selectByCompactFuncWithoutNA[T any](inData []T, byFunc func(T) bool) []bool
 	return booleans
 }
 
-func selectByBriefFuncWithNA[T any](inData []T, inNA []bool, byFunc func(T) bool) []bool {
+func selectByBriefFuncWithNA[T any](inData []T, inNA naBitmap, byFunc func(T) bool) []bool {
 	booleans := make([]bool, len(inData))
 
 	for idx, val := range inData {
-		if !inNA[idx] && byFunc(val) {
+		if !inNA.at(idx) && byFunc(val) {
 			booleans[idx] = true
 		}
 	}
@@ -228,7 +262,7 @@ func selectByBriefFuncWithNA[T any](inData []T, inNA []bool, byFunc func(T) bool
 	return booleans
 }
 
-func applyWithNA[T any](inData []T, inNA []bool, applier any, options []Option) Payload {
+func applyWithNA[T any](inData []T, inNA naBitmap, applier any, options []Option) Payload {
 	if data, na, ok := applyTypeWithNA[T, bool](inData, inNA, applier, false); ok {
 		return BooleanPayload(data, na, options...)
 	}
@@ -269,7 +303,7 @@ func applyWithNA[T any](inData []T, inNA []bool, applier any, options []Option)
 	return NAPayload(len(inData))
 }
 
-func applyTypeWithNA[T, S any](inData []T, inNA []bool, applier any, naDef S) ([]S, []bool, bool) {
+func applyTypeWithNA[T, S any](inData []T, inNA naBitmap, applier any, naDef S) ([]S, []bool, bool) {
 	if applyFunc, ok := applier.(func(int, T, bool) (S, bool)); ok {
 		data, na := applyByFunc[T, S](inData, inNA, applyFunc, naDef)
 		return data, na, true
@@ -334,7 +368,7 @@ func applyByNoNACompactFunc[T, S any](inData []T, applyFunc func(T) S) []S {
 	return data
 }
 
-func applyByFunc[T, S any](inData []T, inNA []bool,
+func applyByFunc[T, S any](inData []T, inNA naBitmap,
 	applyFunc func(int, T, bool) (S, bool), naDef S) ([]S, []bool) {
 	length := len(inData)
 
@@ -342,7 +376,7 @@ func applyByFunc[T, S any](inData []T, inNA []bool,
 	na := make([]bool, length)
 
 	for i := 0; i < length; i++ {
-		dataVal, naVal := applyFunc(i+1, inData[i], inNA[i])
+		dataVal, naVal := applyFunc(i+1, inData[i], inNA.at(i))
 		if naVal {
 			dataVal = naDef
 		}
@@ -353,7 +387,7 @@ func applyByFunc[T, S any](inData []T, inNA []bool,
 	return data, na
 }
 
-func applyByCompactFunc[T, S any](inData []T, inNA []bool,
+func applyByCompactFunc[T, S any](inData []T, inNA naBitmap,
 	applyFunc func(T, bool) (S, bool), naDef S) ([]S, []bool) {
 	length := len(inData)
 
@@ -361,7 +395,7 @@ func applyByCompactFunc[T, S any](inData []T, inNA []bool,
 	na := make([]bool, length)
 
 	for i := 0; i < length; i++ {
-		dataVal, naVal := applyFunc(inData[i], inNA[i])
+		dataVal, naVal := applyFunc(inData[i], inNA.at(i))
 		if naVal {
 			dataVal = naDef
 		}
@@ -372,8 +406,12 @@ func applyByCompactFunc[T, S any](inData []T, inNA []bool,
 	return data, na
 }
 
-func applyByBriefFunc[T, S any](inData []T, inNA []bool,
+func applyByBriefFunc[T, S any](inData []T, inNA naBitmap,
 	applyFunc func(T) S, naDef S) ([]S, []bool) {
+	if inNA == nil {
+		return applyByNoNACompactFunc(inData, applyFunc), nil
+	}
+
 	length := len(inData)
 
 	data := make([]S, length)
@@ -382,7 +420,7 @@ func applyByBriefFunc[T, S any](inData []T, inNA []bool,
 	for i := 0; i < length; i++ {
 		dataVal := naDef
 		naVal := true
-		if !inNA[i] {
+		if !inNA.at(i) {
 			dataVal = applyFunc(inData[i])
 			naVal = false
 		}
@@ -394,7 +432,7 @@ func applyByBriefFunc[T, S any](inData []T, inNA []bool,
 	return data, na
 }
 
-func applyToWithNA[T any](indices []int, inData []T, inNA []bool, applier any, naDef T) ([]T, []bool) {
+func applyToWithNA[T any](indices []int, inData []T, inNA naBitmap, applier any, naDef T) ([]T, []bool) {
 	var data []T = nil
 	var na []bool = nil
 
@@ -412,7 +450,7 @@ func applyToWithNA[T any](indices []int, inData []T, inNA []bool, applier any, n
 	return data, na
 }
 
-func applyToWithNAByFunc[T any](indices []int, inData []T, inNA []bool,
+func applyToWithNAByFunc[T any](indices []int, inData []T, inNA naBitmap,
 	applyFunc func(int, T, bool) (T, bool), naDef T) ([]T, []bool) {
 	length := len(inData)
 
@@ -420,11 +458,11 @@ func applyToWithNAByFunc[T any](indices []int, inData []T, inNA []bool,
 	na := make([]bool, length)
 
 	copy(data, inData)
-	copy(na, inNA)
+	inNA.copyTo(na)
 
 	for _, idx := range indices {
 		idx = idx - 1
-		dataVal, naVal := applyFunc(idx+1, inData[idx], inNA[idx])
+		dataVal, naVal := applyFunc(idx+1, inData[idx], inNA.at(idx))
 		if naVal {
 			dataVal = naDef
 		}
@@ -435,7 +473,7 @@ func applyToWithNAByFunc[T any](indices []int, inData []T, inNA []bool,
 	return data, na
 }
 
-func applyToByWithNACompactFunc[T any](indices []int, inData []T, inNA []bool,
+func applyToByWithNACompactFunc[T any](indices []int, inData []T, inNA naBitmap,
 	applyFunc func(T, bool) (T, bool), naDef T) ([]T, []bool) {
 	length := len(inData)
 
@@ -443,11 +481,11 @@ func applyToByWithNACompactFunc[T any](indices []int, inData []T, inNA []bool,
 	na := make([]bool, length)
 
 	copy(data, inData)
-	copy(na, inNA)
+	inNA.copyTo(na)
 
 	for _, idx := range indices {
 		idx = idx - 1
-		dataVal, naVal := applyFunc(inData[idx], inNA[idx])
+		dataVal, naVal := applyFunc(inData[idx], inNA.at(idx))
 		if naVal {
 			dataVal = naDef
 		}
@@ -458,12 +496,16 @@ func applyToByWithNACompactFunc[T any](indices []int, inData []T, inNA []bool,
 	return data, na
 }
 
-func applyToWithNAByValue[T any](indices []int, inData []T, inNA []bool, val T) ([]T, []bool) {
+func applyToWithNAByValue[T any](indices []int, inData []T, inNA naBitmap, val T) ([]T, []bool) {
+	if inNA == nil {
+		return applyToWithoutNAByValue(indices, inData, val), nil
+	}
+
 	data := make([]T, len(inData))
 	na := make([]bool, len(inData))
 
 	copy(data, inData)
-	copy(na, inNA)
+	inNA.copyTo(na)
 
 	for _, idx := range indices {
 		idx = idx - 1
@@ -532,24 +574,24 @@ func applyToWithoutNAByValue[T any](indices []int, inData []T, val T) []T {
 	return data
 }
 
-func traverseWithNA[T any](inData []T, inNA []bool, traverser any) {
+func traverseWithNA[T any](inData []T, inNA naBitmap, traverser any) {
 	length := len(inData)
 
 	if fn, ok := traverser.(func(int, T, bool)); ok {
 		for i := 0; i < length; i++ {
-			fn(i, inData[i], inNA[i])
+			fn(i, inData[i], inNA.at(i))
 		}
 	}
 
 	if fn, ok := traverser.(func(T, bool)); ok {
 		for i := 0; i < length; i++ {
-			fn(inData[i], inNA[i])
+			fn(inData[i], inNA.at(i))
 		}
 	}
 
 	if fn, ok := traverser.(func(T)); ok {
 		for i := 0; i < length; i++ {
-			if !inNA[i] {
+			if !inNA.at(i) {
 				fn(inData[i])
 			}
 		}
@@ -571,18 +613,22 @@ func traverseWithoutNA[T any](inData []T, traverser any) {
 	}
 }
 
-func applyToByBriefFunc[T any](indices []int, inData []T, inNA []bool, applyFunc func(T) T) ([]T, []bool) {
+func applyToByBriefFunc[T any](indices []int, inData []T, inNA naBitmap, applyFunc func(T) T) ([]T, []bool) {
+	if inNA == nil {
+		return applyToByWithoutNACompactFunc(indices, inData, applyFunc), nil
+	}
+
 	length := len(inData)
 
 	data := make([]T, length)
 	na := make([]bool, length)
 
 	copy(data, inData)
-	copy(na, inNA)
+	inNA.copyTo(na)
 
 	for _, idx := range indices {
 		idx = idx - 1
-		if !inNA[idx] {
+		if !inNA.at(idx) {
 			data[idx] = applyFunc(inData[idx])
 		}
 	}
@@ -590,7 +636,7 @@ func applyToByBriefFunc[T any](indices []int, inData []T, inNA []bool, applyFunc
 	return data, na
 }
 
-func groupsForData[T comparable](srcData []T, srcNA []bool) ([][]int, []any) {
+func groupsForData[T comparable](srcData []T, srcNA naBitmap) ([][]int, []any) {
 	groupMap := map[T][]int{}
 	ordered := []T{}
 	na := []int{}
@@ -598,7 +644,7 @@ func groupsForData[T comparable](srcData []T, srcNA []bool) ([][]int, []any) {
 	for i, val := range srcData {
 		idx := i + 1
 
-		if srcNA[i] {
+		if srcNA.at(i) {
 			na = append(na, idx)
 			continue
 		}
@@ -631,7 +677,7 @@ func groupsForData[T comparable](srcData []T, srcNA []bool) ([][]int, []any) {
 	return groups, values
 }
 
-func groupsForDataWithHash[T any, S comparable](srcData []T, srcNA []bool, fnHash func(T) S) ([][]int, []any) {
+func groupsForDataWithHash[T any, S comparable](srcData []T, srcNA naBitmap, fnHash func(T) S) ([][]int, []any) {
 	groupMap := map[S][]int{}
 	ordered := []T{}
 	na := []int{}
@@ -640,7 +686,7 @@ func groupsForDataWithHash[T any, S comparable](srcData []T, srcNA []bool, fnHas
 		idx := i + 1
 		h := fnHash(val)
 
-		if srcNA[i] {
+		if srcNA.at(i) {
 			na = append(na, idx)
 			continue
 		}
@@ -681,7 +727,7 @@ func supportsSummarizer[T any](summarizer any) bool {
 	return false
 }
 
-func summarize[T any](inData []T, inNA []bool, summarizer any, valInit T, naDef T) (T, bool) {
+func summarize[T any](inData []T, inNA naBitmap, summarizer any, valInit T, naDef T) (T, bool) {
 	fn, ok := summarizer.(func(int, T, T, bool) (T, bool))
 	if ok {
 		return summarizeByFunc(inData, inNA, fn, valInit, naDef)
@@ -690,12 +736,12 @@ func summarize[T any](inData []T, inNA []bool, summarizer any, valInit T, naDef
 	return naDef, true
 }
 
-func summarizeByFunc[T any](inData []T, inNA []bool, fn func(int, T, T, bool) (T, bool), valInit T, naDef T) (T, bool) {
+func summarizeByFunc[T any](inData []T, inNA naBitmap, fn func(int, T, T, bool) (T, bool), valInit T, naDef T) (T, bool) {
 	val := valInit
 	na := false
 
 	for i := 0; i < len(inData); i++ {
-		val, na = fn(i+1, val, inData[i], inNA[i])
+		val, na = fn(i+1, val, inData[i], inNA.at(i))
 		if na {
 			return naDef, true
 		}
@@ -704,7 +750,7 @@ func summarizeByFunc[T any](inData []T, inNA []bool, fn func(int, T, T, bool) (T
 	return val, false
 }
 
-func eq[T comparable](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func eq[T comparable](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -713,17 +759,14 @@ func eq[T comparable](val any, inData []T, inNA []bool, convertor func(any) (T,
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = false
-		} else {
-			cmp[i] = datum == v
-		}
+		cmp[i] = datum == v
 	}
+	inNA.mask(cmp, false)
 
 	return cmp
 }
 
-func eqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool), eqFn func(T, T) bool) []bool {
+func eqFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool), eqFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -732,7 +775,7 @@ func eqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = false
 		} else {
 			cmp[i] = eqFn(datum, v)
@@ -742,7 +785,7 @@ func eqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	return cmp
 }
 
-func neq[T comparable](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func neq[T comparable](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 	v, ok := convertor(val)
 
@@ -755,17 +798,14 @@ func neq[T comparable](val any, inData []T, inNA []bool, convertor func(any) (T,
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = true
-		} else {
-			cmp[i] = datum != v
-		}
+		cmp[i] = datum != v
 	}
+	inNA.mask(cmp, true)
 
 	return cmp
 }
 
-func neqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool), eqFn func(T, T) bool) []bool {
+func neqFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool), eqFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 	v, ok := convertor(val)
 
@@ -778,7 +818,7 @@ func neqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = true
 		} else {
 			cmp[i] = !eqFn(datum, v)
@@ -788,7 +828,7 @@ func neqFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	return cmp
 }
 
-func gt[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func gt[T constraints.Ordered](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -797,17 +837,14 @@ func gt[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = false
-		} else {
-			cmp[i] = datum > v
-		}
+		cmp[i] = datum > v
 	}
+	inNA.mask(cmp, false)
 
 	return cmp
 }
 
-func gtFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool),
+func gtFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool),
 	ltFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 
@@ -817,7 +854,7 @@ func gtFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = false
 		} else {
 			cmp[i] = ltFn(v, datum)
@@ -827,7 +864,7 @@ func gtFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	return cmp
 }
 
-func lt[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func lt[T constraints.Ordered](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -836,17 +873,14 @@ func lt[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = false
-		} else {
-			cmp[i] = datum < v
-		}
+		cmp[i] = datum < v
 	}
+	inNA.mask(cmp, false)
 
 	return cmp
 }
 
-func ltFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool),
+func ltFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool),
 	ltFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 
@@ -856,7 +890,7 @@ func ltFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = false
 		} else {
 			cmp[i] = ltFn(datum, v)
@@ -866,7 +900,7 @@ func ltFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool)
 	return cmp
 }
 
-func gte[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func gte[T constraints.Ordered](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -875,17 +909,14 @@ func gte[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = false
-		} else {
-			cmp[i] = datum >= v
-		}
+		cmp[i] = datum >= v
 	}
+	inNA.mask(cmp, false)
 
 	return cmp
 }
 
-func gteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool),
+func gteFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool),
 	eqFn func(T, T) bool, ltFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 
@@ -895,7 +926,7 @@ func gteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = false
 		} else {
 			cmp[i] = ltFn(v, datum) || eqFn(datum, v)
@@ -905,7 +936,7 @@ func gteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	return cmp
 }
 
-func lte[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func(any) (T, bool)) []bool {
+func lte[T constraints.Ordered](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []bool {
 	cmp := make([]bool, len(inData))
 
 	v, ok := convertor(val)
@@ -914,17 +945,14 @@ func lte[T constraints.Ordered](val any, inData []T, inNA []bool, convertor func
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
-			cmp[i] = false
-		} else {
-			cmp[i] = datum <= v
-		}
+		cmp[i] = datum <= v
 	}
+	inNA.mask(cmp, false)
 
 	return cmp
 }
 
-func lteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool),
+func lteFn[T any](val any, inData []T, inNA naBitmap, convertor func(any) (T, bool),
 	eqFn func(T, T) bool, ltFn func(T, T) bool) []bool {
 	cmp := make([]bool, len(inData))
 
@@ -934,7 +962,7 @@ func lteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	}
 
 	for i, datum := range inData {
-		if inNA[i] {
+		if inNA.at(i) {
 			cmp[i] = false
 		} else {
 			cmp[i] = ltFn(datum, v) || eqFn(v, datum)
@@ -944,14 +972,14 @@ func lteFn[T any](val any, inData []T, inNA []bool, convertor func(any) (T, bool
 	return cmp
 }
 
-func find[T comparable](needle any, inData []T, inNA []bool, convertor func(any) (T, bool)) int {
+func find[T comparable](needle any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) int {
 	val, ok := convertor(needle)
 	if !ok {
 		return 0
 	}
 
 	for i, datum := range inData {
-		if !inNA[i] && val == datum {
+		if !inNA.at(i) && val == datum {
 			return i + 1
 		}
 	}
@@ -959,14 +987,14 @@ func find[T comparable](needle any, inData []T, inNA []bool, convertor func(any)
 	return 0
 }
 
-func findFn[T any](needle any, inData []T, inNA []bool, convertor func(any) (T, bool), eqFn func(T, T) bool) int {
+func findFn[T any](needle any, inData []T, inNA naBitmap, convertor func(any) (T, bool), eqFn func(T, T) bool) int {
 	val, ok := convertor(needle)
 	if !ok {
 		return 0
 	}
 
 	for i, datum := range inData {
-		if !inNA[i] && eqFn(val, datum) {
+		if !inNA.at(i) && eqFn(val, datum) {
 			return i + 1
 		}
 	}
@@ -974,7 +1002,7 @@ func findFn[T any](needle any, inData []T, inNA []bool, convertor func(any) (T,
 	return 0
 }
 
-func findAll[T comparable](needle any, inData []T, inNA []bool, convertor func(any) (T, bool)) []int {
+func findAll[T comparable](needle any, inData []T, inNA naBitmap, convertor func(any) (T, bool)) []int {
 	val, ok := convertor(needle)
 	if !ok {
 		return []int{}
@@ -982,7 +1010,7 @@ func findAll[T comparable](needle any, inData []T, inNA []bool, convertor func(a
 
 	found := []int{}
 	for i, datum := range inData {
-		if !inNA[i] && val == datum {
+		if !inNA.at(i) && val == datum {
 			found = append(found, i+1)
 		}
 	}
@@ -990,7 +1018,7 @@ func findAll[T comparable](needle any, inData []T, inNA []bool, convertor func(a
 	return found
 }
 
-func findAllFn[T any](needle any, inData []T, inNA []bool, convertor func(any) (T, bool),
+func findAllFn[T any](needle any, inData []T, inNA naBitmap, convertor func(any) (T, bool),
 	eqFn func(T, T) bool) []int {
 	val, ok := convertor(needle)
 	if !ok {
@@ -999,7 +1027,7 @@ func findAllFn[T any](needle any, inData []T, inNA []bool, convertor func(any) (
 
 	found := []int{}
 	for i, datum := range inData {
-		if !inNA[i] && eqFn(val, datum) {
+		if !inNA.at(i) && eqFn(val, datum) {
 			found = append(found, i+1)
 		}
 	}
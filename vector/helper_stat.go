@@ -1,32 +1,34 @@
 package vector
 
-import "golang.org/x/exp/constraints"
+import (
+	"math"
+	"sort"
 
-func genSum[T numeric](data []T, na []bool) (T, bool) {
+	"golang.org/x/exp/constraints"
+)
+
+func genSum[T numeric](data []T, na naBitmap) (T, bool) {
 	var sum T
 
-	for i, val := range data {
-		if na[i] {
-			return 0, true
-		}
+	if na != nil {
+		return 0, true
+	}
+
+	for _, val := range data {
 		sum += val
 	}
 
 	return sum, false
 }
 
-func genMin[T constraints.Ordered](data []T, na []bool) (T, bool) {
+func genMin[T constraints.Ordered](data []T, na naBitmap) (T, bool) {
 	var min T
-	if len(data) == 0 {
+	if len(data) == 0 || na != nil {
 		return min, true
 	}
 
 	min = data[0]
 	for i := 1; i < len(data); i++ {
-		if na[i] {
-			return min, true
-		}
-
 		if data[i] < min {
 			min = data[i]
 		}
@@ -35,18 +37,14 @@ func genMin[T constraints.Ordered](data []T, na []bool) (T, bool) {
 	return min, false
 }
 
-func genMax[T constraints.Ordered](data []T, na []bool) (T, bool) {
+func genMax[T constraints.Ordered](data []T, na naBitmap) (T, bool) {
 	var max T
-	if len(data) == 0 {
+	if len(data) == 0 || na != nil {
 		return max, true
 	}
 
 	max = data[0]
 	for i := 1; i < len(data); i++ {
-		if na[i] {
-			return max, true
-		}
-
 		if data[i] > max {
 			max = data[i]
 		}
@@ -63,7 +61,7 @@ type numeric interface {
 	constraints.Integer | constraints.Float | constraints.Complex
 }
 
-func genMean[T calculable](data []T, na []bool) (float64, bool) {
+func genMean[T calculable](data []T, na naBitmap) (float64, bool) {
 	var sum float64
 	length := len(data)
 
@@ -71,11 +69,11 @@ func genMean[T calculable](data []T, na []bool) (float64, bool) {
 		return 0, false
 	}
 
-	for i := 0; i < length; i++ {
-		if na[i] {
-			return 0, true
-		}
+	if na != nil {
+		return 0, true
+	}
 
+	for i := 0; i < length; i++ {
 		sum += float64(data[i])
 	}
 
@@ -108,7 +106,7 @@ func genMedian[T constraints.Integer | constraints.Float](
 	return median, false
 }
 
-func genProd[T constraints.Integer | constraints.Float | constraints.Complex](data []T, na []bool) (T, bool) {
+func genProd[T constraints.Integer | constraints.Float | constraints.Complex](data []T, na naBitmap) (T, bool) {
 	var product T
 	length := len(data)
 
@@ -116,37 +114,33 @@ func genProd[T constraints.Integer | constraints.Float | constraints.Complex](da
 		return 0, false
 	}
 
-	if length == 1 {
-		return data[0], false
+	if na != nil {
+		return 0, true
 	}
 
 	product = data[0]
 	for i := 1; i < length; i++ {
-		if na[i] {
-			return 0, true
-		}
-
 		product *= data[i]
 	}
 
 	return product, false
 }
 
-func genCumSum[T numeric](data []T, na []bool, naDef T) ([]T, []bool) {
+func genCumSum[T numeric](data []T, na naBitmap, naDef T) ([]T, []bool) {
 	length := len(data)
 	if length == 0 {
 		return []T{}, []bool{}
 	}
 
 	if length == 1 {
-		return []T{data[0]}, []bool{na[0]}
+		return []T{data[0]}, []bool{na.at(0)}
 	}
 
 	cumSum := make([]T, length)
 	cumNA := make([]bool, length)
 	copy(cumSum, data)
-	copy(cumNA, na)
-	isNA := na[0]
+	na.copyTo(cumNA)
+	isNA := na.at(0)
 	for i := 1; i < length; i++ {
 		if isNA {
 			cumSum[i] = naDef
@@ -154,7 +148,7 @@ func genCumSum[T numeric](data []T, na []bool, naDef T) ([]T, []bool) {
 			continue
 		}
 
-		if na[i] {
+		if na.at(i) {
 			cumSum[i] = naDef
 			cumNA[i] = true
 			isNA = true
@@ -167,21 +161,21 @@ func genCumSum[T numeric](data []T, na []bool, naDef T) ([]T, []bool) {
 	return cumSum, cumNA
 }
 
-func genCumProd[T constraints.Integer | constraints.Float | constraints.Complex](data []T, na []bool, naDef T) ([]T, []bool) {
+func genCumProd[T constraints.Integer | constraints.Float | constraints.Complex](data []T, na naBitmap, naDef T) ([]T, []bool) {
 	length := len(data)
 	if length == 0 {
 		return []T{}, []bool{}
 	}
 
 	if length == 1 {
-		return []T{data[0]}, []bool{na[0]}
+		return []T{data[0]}, []bool{na.at(0)}
 	}
 
 	cumProd := make([]T, length)
 	cumNA := make([]bool, length)
 	copy(cumProd, data)
-	copy(cumNA, na)
-	isNA := na[0]
+	na.copyTo(cumNA)
+	isNA := na.at(0)
 	for i := 1; i < length; i++ {
 		if isNA {
 			cumProd[i] = naDef
@@ -189,7 +183,7 @@ func genCumProd[T constraints.Integer | constraints.Float | constraints.Complex]
 			continue
 		}
 
-		if na[i] {
+		if na.at(i) {
 			cumProd[i] = naDef
 			cumNA[i] = true
 			isNA = true
@@ -202,21 +196,21 @@ func genCumProd[T constraints.Integer | constraints.Float | constraints.Complex]
 	return cumProd, cumNA
 }
 
-func genCumMax[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool) {
+func genCumMax[T constraints.Ordered](data []T, na naBitmap, naDef T) ([]T, []bool) {
 	length := len(data)
 	if length == 0 {
 		return []T{}, []bool{}
 	}
 
 	if length == 1 {
-		return []T{data[0]}, []bool{na[0]}
+		return []T{data[0]}, []bool{na.at(0)}
 	}
 
 	cumMax := make([]T, length)
 	cumNA := make([]bool, length)
 	copy(cumMax, data)
-	copy(cumNA, na)
-	isNA := na[0]
+	na.copyTo(cumNA)
+	isNA := na.at(0)
 	for i := 1; i < length; i++ {
 		if isNA {
 			cumMax[i] = naDef
@@ -224,7 +218,7 @@ func genCumMax[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool
 			continue
 		}
 
-		if na[i] {
+		if na.at(i) {
 			cumMax[i] = naDef
 			cumNA[i] = true
 			isNA = true
@@ -239,21 +233,21 @@ func genCumMax[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool
 	return cumMax, cumNA
 }
 
-func genCumMin[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool) {
+func genCumMin[T constraints.Ordered](data []T, na naBitmap, naDef T) ([]T, []bool) {
 	length := len(data)
 	if length == 0 {
 		return []T{}, []bool{}
 	}
 
 	if length == 1 {
-		return []T{data[0]}, []bool{na[0]}
+		return []T{data[0]}, []bool{na.at(0)}
 	}
 
 	cumMin := make([]T, length)
 	cumNA := make([]bool, length)
 	copy(cumMin, data)
-	copy(cumNA, na)
-	isNA := na[0]
+	na.copyTo(cumNA)
+	isNA := na.at(0)
 	for i := 1; i < length; i++ {
 		if isNA {
 			cumMin[i] = naDef
@@ -261,7 +255,7 @@ func genCumMin[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool
 			continue
 		}
 
-		if na[i] {
+		if na.at(i) {
 			cumMin[i] = naDef
 			cumNA[i] = true
 			isNA = true
@@ -275,3 +269,142 @@ func genCumMin[T constraints.Ordered](data []T, na []bool, naDef T) ([]T, []bool
 
 	return cumMin, cumNA
 }
+
+func genVar[T calculable](data []T, na naBitmap) (float64, bool) {
+	length := len(data)
+	if length < 2 || na != nil {
+		return 0, true
+	}
+
+	mean, _ := genMean(data, na)
+
+	var sum float64
+	for _, val := range data {
+		diff := float64(val) - mean
+		sum += diff * diff
+	}
+
+	return sum / float64(length-1), false
+}
+
+func genSd[T calculable](data []T, na naBitmap) (float64, bool) {
+	variance, na1 := genVar(data, na)
+	if na1 {
+		return 0, true
+	}
+
+	return math.Sqrt(variance), false
+}
+
+func genQuantile[T calculable](data []T, na naBitmap, probs []float64) ([]float64, []bool) {
+	quantiles := make([]float64, len(probs))
+	quantileNA := make([]bool, len(probs))
+
+	if len(data) == 0 || na != nil {
+		for i := range quantileNA {
+			quantileNA[i] = true
+		}
+
+		return quantiles, quantileNA
+	}
+
+	sorted := sortedFloats(data)
+	for i, prob := range probs {
+		if prob < 0 || prob > 1 || math.IsNaN(prob) {
+			quantileNA[i] = true
+			continue
+		}
+
+		quantiles[i] = quantileOfSorted(sorted, prob)
+	}
+
+	return quantiles, quantileNA
+}
+
+func genIQR[T calculable](data []T, na naBitmap) (float64, bool) {
+	quantiles, quantileNA := genQuantile(data, na, []float64{0.25, 0.75})
+	if quantileNA[0] {
+		return 0, true
+	}
+
+	return quantiles[1] - quantiles[0], false
+}
+
+func genMad[T calculable](data []T, na naBitmap) (float64, bool) {
+	if len(data) == 0 || na != nil {
+		return 0, true
+	}
+
+	median := quantileOfSorted(sortedFloats(data), 0.5)
+
+	deviations := make([]float64, len(data))
+	for i, val := range data {
+		deviations[i] = math.Abs(float64(val) - median)
+	}
+	sort.Float64s(deviations)
+
+	return 1.4826 * quantileOfSorted(deviations, 0.5), false
+}
+
+func genSkewness[T calculable](data []T, na naBitmap) (float64, bool) {
+	m2, m3, _, ok := centralMoments(data, na)
+	if !ok || m2 == 0 {
+		return 0, true
+	}
+
+	return m3 / math.Pow(m2, 1.5), false
+}
+
+func genKurtosis[T calculable](data []T, na naBitmap) (float64, bool) {
+	m2, _, m4, ok := centralMoments(data, na)
+	if !ok || m2 == 0 {
+		return 0, true
+	}
+
+	return m4/(m2*m2) - 3, false
+}
+
+// centralMoments returns the second, third and fourth central moments of the data.
+func centralMoments[T calculable](data []T, na naBitmap) (float64, float64, float64, bool) {
+	length := len(data)
+	if length == 0 || na != nil {
+		return 0, 0, 0, false
+	}
+
+	mean, _ := genMean(data, na)
+
+	var m2, m3, m4 float64
+	for _, val := range data {
+		diff := float64(val) - mean
+		m2 += diff * diff
+		m3 += diff * diff * diff
+		m4 += diff * diff * diff * diff
+	}
+
+	n := float64(length)
+
+	return m2 / n, m3 / n, m4 / n, true
+}
+
+func sortedFloats[T calculable](data []T) []float64 {
+	sorted := make([]float64, len(data))
+	for i, val := range data {
+		sorted[i] = float64(val)
+	}
+	sort.Float64s(sorted)
+
+	return sorted
+}
+
+// quantileOfSorted calculates a quantile of sorted data using the type 7 definition from R.
+func quantileOfSorted(sorted []float64, prob float64) float64 {
+	h := float64(len(sorted)-1) * prob
+	lo := math.Floor(h)
+	loIdx := int(lo)
+
+	if loIdx+1 >= len(sorted) {
+		return sorted[loIdx]
+	}
+
+	return sorted[loIdx] + (h-lo)*(sorted[loIdx+1]-sorted[loIdx])
+}
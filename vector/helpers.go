@@ -56,40 +56,65 @@ func CompareVectorsForTest(one, two Vector) bool {
 	}
 
 	var ok bool
-	switch vec1.payload.(type) {
+	switch vec1.Payload().(type) {
 	case *booleanPayload:
-		p1 := vec1.payload.(*booleanPayload)
-		p2 := vec2.payload.(*booleanPayload)
+		p1 := vec1.Payload().(*booleanPayload)
+		p2 := vec2.Payload().(*booleanPayload)
 		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *integerPayload:
-		p1 := vec1.payload.(*integerPayload)
-		p2 := vec2.payload.(*integerPayload)
+		p1 := vec1.Payload().(*integerPayload)
+		p2 := vec2.Payload().(*integerPayload)
 		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *complexPayload:
-		p1 := vec1.payload.(*complexPayload)
-		p2 := vec2.payload.(*complexPayload)
+		p1 := vec1.Payload().(*complexPayload)
+		p2 := vec2.Payload().(*complexPayload)
 		ok = util.EqualComplexArrays(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *floatPayload:
-		p1 := vec1.payload.(*floatPayload)
-		p2 := vec2.payload.(*floatPayload)
+		p1 := vec1.Payload().(*floatPayload)
+		p2 := vec2.Payload().(*floatPayload)
 		ok = util.EqualFloatArrays(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *anyPayload:
-		p1 := vec1.payload.(*anyPayload)
-		p2 := vec2.payload.(*anyPayload)
+		p1 := vec1.Payload().(*anyPayload)
+		p2 := vec2.Payload().(*anyPayload)
 		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *naPayload:
 		ok = vec1.length == vec2.length
 	case *stringPayload:
-		p1 := vec1.payload.(*stringPayload)
-		p2 := vec2.payload.(*stringPayload)
+		p1 := vec1.Payload().(*stringPayload)
+		p2 := vec2.Payload().(*stringPayload)
 		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
 	case *timePayload:
-		p1 := vec1.payload.(*timePayload)
-		p2 := vec2.payload.(*timePayload)
+		p1 := vec1.Payload().(*timePayload)
+		p2 := vec2.Payload().(*timePayload)
 		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
+	case *datePayload:
+		p1 := vec1.Payload().(*datePayload)
+		p2 := vec2.Payload().(*datePayload)
+		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
+	case *durationPayload:
+		p1 := vec1.Payload().(*durationPayload)
+		p2 := vec2.Payload().(*durationPayload)
+		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
+	case *narrowPayload[int32]:
+		ok = compareNarrowPayloadsForTest[int32](vec1.Payload(), vec2.Payload())
+	case *narrowPayload[int64]:
+		ok = compareNarrowPayloadsForTest[int64](vec1.Payload(), vec2.Payload())
+	case *narrowPayload[uint8]:
+		ok = compareNarrowPayloadsForTest[uint8](vec1.Payload(), vec2.Payload())
+	case *narrowPayload[float32]:
+		ok = compareNarrowPayloadsForTest[float32](vec1.Payload(), vec2.Payload())
+	case *decimalPayload:
+		p1 := vec1.Payload().(*decimalPayload)
+		p2 := vec2.Payload().(*decimalPayload)
+		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na) && p1.scale == p2.scale
+	case *factorPayload:
+		p1 := vec1.Payload().(*factorPayload)
+		p2 := vec2.Payload().(*factorPayload)
+		ok = reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na) &&
+			reflect.DeepEqual(p1.levels, p2.levels) && p1.ordered == p2.ordered
 	case *vectorPayload:
-		p1 := vec1.payload.(*vectorPayload)
-		p2 := vec2.payload.(*vectorPayload)
+		p1 := vec1.Payload().(*vectorPayload)
+		p2 := vec2.Payload().(*vectorPayload)
 		ok = CompareVectorArrs(p1.data, p2.data)
 	default:
 		ok = false
@@ -98,6 +123,13 @@ func CompareVectorsForTest(one, two Vector) bool {
 	return ok
 }
 
+func compareNarrowPayloadsForTest[T narrow](one, two Payload) bool {
+	p1 := one.(*narrowPayload[T])
+	p2 := two.(*narrowPayload[T])
+
+	return reflect.DeepEqual(p1.data, p2.data) && reflect.DeepEqual(p1.na, p2.na)
+}
+
 func CompareVectorArrs(arr1, arr2 []Vector) bool {
 	if len(arr1) != len(arr2) {
 		return false
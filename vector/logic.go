@@ -1,5 +1,7 @@
 package vector
 
+import "golang.org/x/exp/slices"
+
 // And applies logical AND operation to all provided boolean slices. Second and next slices are being fitted to
 // the size of the first one.
 func And(booleans ...[]bool) []bool {
@@ -100,3 +102,282 @@ func fitCmpToSrc(src, cmp []bool) []bool {
 
 	return cmp
 }
+
+// Logical interface is implemented by vectors to support logical operations with three-valued logic. Elements
+// of vectors are converted to booleans, and NA-values are unknown values: NA & false is false, NA | true is true,
+// and in all other cases an operation with NA results in NA.
+type Logical interface {
+	// And applies logical AND to the vector and all provided vectors. Provided vectors are fitted to the size
+	// of the vector.
+	And(...Vector) Vector
+	// Or applies logical OR to the vector and all provided vectors. Provided vectors are fitted to the size
+	// of the vector.
+	Or(...Vector) Vector
+	// Xor applies logical XOR to the vector and all provided vectors. Provided vectors are fitted to the size
+	// of the vector.
+	Xor(...Vector) Vector
+	// Not applies logical NOT to the vector.
+	Not() Vector
+}
+
+func (v *vector) And(vectors ...Vector) Vector {
+	return v.logical(vectors, func(x, xNA, y, yNA bool) (bool, bool) {
+		if !x && !xNA || !y && !yNA {
+			return false, false
+		}
+
+		return true, xNA || yNA
+	})
+}
+
+func (v *vector) Or(vectors ...Vector) Vector {
+	return v.logical(vectors, func(x, xNA, y, yNA bool) (bool, bool) {
+		if x && !xNA || y && !yNA {
+			return true, false
+		}
+
+		return false, xNA || yNA
+	})
+}
+
+func (v *vector) Xor(vectors ...Vector) Vector {
+	return v.logical(vectors, func(x, xNA, y, yNA bool) (bool, bool) {
+		if xNA || yNA {
+			return false, true
+		}
+
+		return x != y, false
+	})
+}
+
+func (v *vector) Not() Vector {
+	booleans, na := v.Booleans()
+
+	for i := range booleans {
+		booleans[i] = !booleans[i] && !na[i]
+	}
+
+	return BooleanWithNA(booleans, na, v.Options()...)
+}
+
+func (v *vector) logical(vectors []Vector, op func(x, xNA, y, yNA bool) (bool, bool)) Vector {
+	booleans, na := v.Booleans()
+
+	for _, vec := range vectors {
+		cmp, cmpNA := fitToLength(vec, v.length).Booleans()
+		for i := range booleans {
+			booleans[i], na[i] = op(booleans[i], na[i], cmp[i], cmpNA[i])
+		}
+	}
+
+	for i := range booleans {
+		if na[i] {
+			booleans[i] = false
+		}
+	}
+
+	return BooleanWithNA(booleans, na, v.Options()...)
+}
+
+// IfElse returns a vector of the length of cond, where elements are taken from yes if the corresponding element
+// of cond is true and from no if it is false. Elements are NA where cond is NA. Branches are coerced to a common
+// type: booleans, integers, floats and complexes to the widest of them, times and dates to times, and all other
+// mixes to strings. An NA-branch takes the type of the other one. The resulting vector has no name.
+func IfElse(cond, yes, no Vector) Vector {
+	length := cond.Len()
+	booleans, na := cond.Booleans()
+
+	branches := coerceToCommonType(fitToLength(yes, length), fitToLength(no, length))
+	first, second := branches[0], branches[1]
+	firstOffset, secondOffset := 0, length
+	if first.Type() == PayloadTypeNA {
+		first, second = second, first
+		firstOffset, secondOffset = secondOffset, firstOffset
+	}
+
+	indices := make([]int, length)
+	for i := range indices {
+		switch {
+		case na[i]:
+			indices[i] = 0
+		case booleans[i]:
+			indices[i] = firstOffset + i + 1
+		default:
+			indices[i] = secondOffset + i + 1
+		}
+	}
+
+	return first.Append(second).ByIndices(indices).SetName("")
+}
+
+// When is a case for CaseWhen: the value is used where the condition is true. A case without a condition matches
+// all elements and can be used as a default one.
+type When struct {
+	Cond  Vector
+	Value Vector
+}
+
+// CaseWhen returns a vector where each element is taken from the value of the first case, the condition of which
+// is true for the element. NA-conditions are treated as not matched, and elements matched by no case are NA.
+// The length of the result is the length of the first condition. Values are coerced to a common type in the same
+// way as IfElse does.
+func CaseWhen(whens ...When) Vector {
+	if len(whens) == 0 {
+		return NA(0)
+	}
+
+	length := 0
+	for _, when := range whens {
+		if when.Cond != nil {
+			length = when.Cond.Len()
+			break
+		}
+	}
+
+	var result Vector = NA(length)
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].Cond == nil {
+			result = fitToLength(whens[i].Value, length)
+			continue
+		}
+
+		cond := whens[i].Cond
+		matched := cond.And(Boolean(cond.NotNA()))
+		result = IfElse(matched, whens[i].Value, result)
+	}
+
+	return result
+}
+
+func fitToLength(vec Vector, length int) Vector {
+	if vec == nil || vec.Len() == 0 {
+		return NA(length)
+	}
+
+	if vec.Len() != length {
+		return vec.Adjust(length)
+	}
+
+	return vec
+}
+
+var coercionRanks = map[string]int{
+	PayloadTypeBoolean: 1,
+	PayloadTypeUint8:   2,
+	PayloadTypeInt32:   2,
+	PayloadTypeInt64:   2,
+	PayloadTypeInteger: 2,
+	PayloadTypeDecimal: 3,
+	PayloadTypeFloat32: 4,
+	PayloadTypeFloat:   4,
+	PayloadTypeComplex: 5,
+}
+
+var coercionTypes = []string{"", PayloadTypeBoolean, PayloadTypeInteger, PayloadTypeDecimal, PayloadTypeFloat,
+	PayloadTypeComplex}
+
+// coerceToCommonType converts vectors to a common type. NA-vectors are left as they are. Integers mixed with
+// decimals become decimals with the largest scale of the decimal vectors.
+func coerceToCommonType(vecs ...Vector) []Vector {
+	common := ""
+	same := true
+	rank := 0
+	for _, vec := range vecs {
+		vecType := vec.Type()
+		if vecType == PayloadTypeNA {
+			continue
+		}
+
+		if common == "" {
+			common = vecType
+		} else if common != vecType {
+			same = false
+		}
+
+		if vecRank, ok := coercionRanks[vecType]; ok && rank >= 0 {
+			if vecRank > rank {
+				rank = vecRank
+			}
+		} else {
+			rank = -1
+		}
+	}
+
+	if same {
+		return vecs
+	}
+
+	switch {
+	case rank > 0:
+		common = coercionTypes[rank]
+	case onlyTypes(vecs, PayloadTypeTime, PayloadTypeDate):
+		common = PayloadTypeTime
+	default:
+		common = PayloadTypeString
+	}
+
+	var options []Option
+	if common == PayloadTypeDecimal {
+		options = decimalCoercionOptions(vecs)
+	}
+
+	coerced := make([]Vector, len(vecs))
+	for i, vec := range vecs {
+		coerced[i] = coerceVector(vec, common, options...)
+	}
+
+	return coerced
+}
+
+func onlyTypes(vecs []Vector, types ...string) bool {
+	for _, vec := range vecs {
+		vecType := vec.Type()
+		if vecType != PayloadTypeNA && !slices.Contains(types, vecType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decimalCoercionOptions returns the largest scale and the rounding mode of the first decimal vector.
+func decimalCoercionOptions(vecs []Vector) []Option {
+	scale := -1
+	rounding := ""
+	for _, vec := range vecs {
+		if decimal, ok := vec.Payload().(*decimalPayload); ok {
+			if decimal.scale > scale {
+				scale = decimal.scale
+			}
+			if rounding == "" {
+				rounding = decimal.rounding
+			}
+		}
+	}
+
+	return []Option{OptionDecimalScale(scale), OptionDecimalRounding(rounding)}
+}
+
+// coerceVector converts the vector to the type, options are used for the decimal type.
+func coerceVector(vec Vector, vecType string, options ...Option) Vector {
+	if vec.Type() == vecType || vec.Type() == PayloadTypeNA {
+		return vec
+	}
+
+	switch vecType {
+	case PayloadTypeBoolean:
+		return vec.AsBoolean()
+	case PayloadTypeInteger:
+		return vec.AsInteger()
+	case PayloadTypeDecimal:
+		return vec.AsDecimal(options...)
+	case PayloadTypeFloat:
+		return vec.AsFloat()
+	case PayloadTypeComplex:
+		return vec.AsComplex()
+	case PayloadTypeTime:
+		return vec.AsTime()
+	}
+
+	return vec.AsString()
+}
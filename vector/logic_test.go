@@ -238,3 +238,200 @@ func TestNot(t *testing.T) {
 		})
 	}
 }
+
+func TestVector_Logical(t *testing.T) {
+	x := BooleanWithNA(
+		[]bool{true, true, true, false, false, false, false, false, false},
+		[]bool{false, false, false, false, false, false, true, true, true},
+	)
+	y := BooleanWithNA(
+		[]bool{true, false, false, true, false, false, true, false, false},
+		[]bool{false, false, true, false, false, true, false, false, true},
+	)
+
+	testData := []struct {
+		name   string
+		result Vector
+		expect Vector
+	}{
+		{
+			name:   "and",
+			result: x.And(y),
+			expect: BooleanWithNA(
+				[]bool{true, false, false, false, false, false, false, false, false},
+				[]bool{false, false, true, false, false, false, true, false, true},
+			),
+		},
+		{
+			name:   "or",
+			result: x.Or(y),
+			expect: BooleanWithNA(
+				[]bool{true, true, true, true, false, false, true, false, false},
+				[]bool{false, false, false, false, false, true, false, true, true},
+			),
+		},
+		{
+			name:   "xor",
+			result: x.Xor(y),
+			expect: BooleanWithNA(
+				[]bool{false, true, false, true, false, false, false, false, false},
+				[]bool{false, false, true, false, false, true, true, true, true},
+			),
+		},
+		{
+			name:   "not",
+			result: x.Not(),
+			expect: BooleanWithNA(
+				[]bool{false, false, false, true, true, true, false, false, false},
+				[]bool{false, false, false, false, false, false, true, true, true},
+			),
+		},
+		{
+			name:   "and with false",
+			result: y.And(Boolean([]bool{false})),
+			expect: Boolean([]bool{false, false, false, false, false, false, false, false, false}),
+		},
+		{
+			name: "multiple and recycled",
+			result: Boolean([]bool{true, true, true, true}).
+				And(Boolean([]bool{true, false}), BooleanWithNA([]bool{true}, []bool{true})),
+			expect: BooleanWithNA([]bool{false, false, false, false}, []bool{true, false, true, false}),
+		},
+		{
+			name:   "empty operand",
+			result: Boolean([]bool{true, false}).Or(Boolean([]bool{})),
+			expect: BooleanWithNA([]bool{true, false}, []bool{false, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.expect))
+			}
+		})
+	}
+}
+
+func TestIfElse(t *testing.T) {
+	cond := BooleanWithNA([]bool{true, false, true, false}, []bool{false, false, false, true})
+
+	testData := []struct {
+		name   string
+		yes    Vector
+		no     Vector
+		expect Vector
+	}{
+		{
+			name:   "same types",
+			yes:    Integer([]int{1, 2, 3, 4}),
+			no:     Integer([]int{10, 20, 30, 40}),
+			expect: IntegerWithNA([]int{1, 20, 3, 0}, []bool{false, false, false, true}),
+		},
+		{
+			name:   "recycled",
+			yes:    String([]string{"yes"}),
+			no:     String([]string{"no"}),
+			expect: StringWithNA([]string{"yes", "no", "yes", ""}, []bool{false, false, false, true}),
+		},
+		{
+			name:   "integer and float",
+			yes:    Integer([]int{1, 2, 3, 4}),
+			no:     Float([]float64{0.5}),
+			expect: FloatWithNA([]float64{1, 0.5, 3, 0}, []bool{false, false, false, true}),
+		},
+		{
+			name:   "integer and decimal",
+			yes:    Integer([]int{1, 2, 3, 4}),
+			no:     Decimal([]int64{1255}, OptionDecimalScale(3)),
+			expect: DecimalWithNA([]int64{1000, 1255, 3000, 0}, []bool{false, false, false, true}, OptionDecimalScale(3)),
+		},
+		{
+			name:   "decimal and float",
+			yes:    Decimal([]int64{150}),
+			no:     Float([]float64{0.25}),
+			expect: FloatWithNA([]float64{1.5, 0.25, 1.5, 0}, []bool{false, false, false, true}),
+		},
+		{
+			name:   "integer and string",
+			yes:    String([]string{"yes"}),
+			no:     Integer([]int{1, 2, 3, 4}),
+			expect: StringWithNA([]string{"yes", "2", "yes", ""}, []bool{false, false, false, true}),
+		},
+		{
+			name:   "NA yes",
+			yes:    NA(1),
+			no:     Float([]float64{1.5, 2.5, 3.5, 4.5}),
+			expect: FloatWithNA([]float64{0, 2.5, 0, 0}, []bool{true, false, true, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := IfElse(cond, data.yes, data.no)
+			if !CompareVectorsForTest(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
+
+func TestCaseWhen(t *testing.T) {
+	x := IntegerWithNA([]int{1, 5, 10, 0, 20}, []bool{false, false, false, true, false})
+
+	testData := []struct {
+		name   string
+		whens  []When
+		expect Vector
+	}{
+		{
+			name: "with default",
+			whens: []When{
+				{Cond: Boolean(x.Lt(3)), Value: String([]string{"small"})},
+				{Cond: Boolean(x.Lt(15)), Value: String([]string{"medium"})},
+				{Value: String([]string{"large"})},
+			},
+			expect: String([]string{"small", "medium", "medium", "large", "large"}),
+		},
+		{
+			name: "coerced values",
+			whens: []When{
+				{Cond: Boolean(x.Lt(3)), Value: Integer([]int{0})},
+				{Cond: Boolean(x.Lt(15)), Value: Float([]float64{0.5})},
+			},
+			expect: FloatWithNA([]float64{0, 0.5, 0.5, 0, 0}, []bool{false, false, false, true, true}),
+		},
+		{
+			name: "without default",
+			whens: []When{
+				{Cond: Boolean(x.Gte(10)), Value: x},
+			},
+			expect: IntegerWithNA([]int{0, 0, 10, 0, 20}, []bool{true, true, false, true, false}),
+		},
+		{
+			name: "NA condition",
+			whens: []When{
+				{
+					Cond:  BooleanWithNA([]bool{false, true, false, false, false}, []bool{true, false, false, false, false}),
+					Value: String([]string{"first"}),
+				},
+				{Value: String([]string{"rest"})},
+			},
+			expect: String([]string{"rest", "first", "rest", "rest", "rest"}),
+		},
+		{
+			name:   "empty",
+			whens:  []When{},
+			expect: NA(0),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := CaseWhen(data.whens...)
+			if !CompareVectorsForTest(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
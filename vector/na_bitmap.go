@@ -0,0 +1,171 @@
+package vector
+
+import (
+	"math/bits"
+)
+
+const naBitmapWordSize = 64
+
+// naBitmap is a packed representation of NA-flags, one bit per element. A bitmap of a payload without NA-values
+// is always nil, so checks for NA can be skipped entirely for such payloads.
+type naBitmap []uint64
+
+// newNABitmap packs NA-flags into a bitmap. It returns nil if there are no NA-values.
+func newNABitmap(na []bool) naBitmap {
+	var bitmap naBitmap
+
+	for i, isNA := range na {
+		if !isNA {
+			continue
+		}
+
+		if bitmap == nil {
+			bitmap = make(naBitmap, (len(na)+naBitmapWordSize-1)/naBitmapWordSize)
+		}
+		bitmap[i/naBitmapWordSize] |= 1 << (uint(i) % naBitmapWordSize)
+	}
+
+	return bitmap
+}
+
+// newFullNABitmap creates a bitmap where all the elements are NA.
+func newFullNABitmap(length int) naBitmap {
+	if length == 0 {
+		return nil
+	}
+
+	bitmap := make(naBitmap, (length+naBitmapWordSize-1)/naBitmapWordSize)
+	for i := range bitmap {
+		bitmap[i] = ^uint64(0)
+	}
+	if rest := length % naBitmapWordSize; rest != 0 {
+		bitmap[len(bitmap)-1] = 1<<uint(rest) - 1
+	}
+
+	return bitmap
+}
+
+// at returns true if the element with the zero-based index is NA. Indices beyond the bitmap are not NA.
+func (b naBitmap) at(i int) bool {
+	w := i / naBitmapWordSize
+	if w >= len(b) {
+		return false
+	}
+
+	return b[w]&(1<<(uint(i)%naBitmapWordSize)) != 0
+}
+
+// bools unpacks the bitmap into NA-flags of the given length.
+func (b naBitmap) bools(length int) []bool {
+	na := make([]bool, length)
+	b.mask(na, true)
+
+	return na
+}
+
+// copyTo unpacks the bitmap into dst like copy() does for NA-flags.
+func (b naBitmap) copyTo(dst []bool) {
+	for i := range dst {
+		dst[i] = false
+	}
+	b.mask(dst, true)
+}
+
+// mask sets elements of dst which are NA to val. Only words with NA-values are visited.
+func (b naBitmap) mask(dst []bool, val bool) {
+	for w, word := range b {
+		base := w * naBitmapWordSize
+		for word != 0 {
+			idx := base + bits.TrailingZeros64(word)
+			if idx >= len(dst) {
+				return
+			}
+			dst[idx] = val
+			word &= word - 1
+		}
+	}
+}
+
+// slice returns a bitmap for the elements from the given zero-based range. Words are shifted as a whole, and the
+// result is nil if there are no NA-values in the range.
+func (b naBitmap) slice(from, to int) naBitmap {
+	if b == nil || from >= to {
+		return nil
+	}
+
+	length := to - from
+	sliced := make(naBitmap, (length+naBitmapWordSize-1)/naBitmapWordSize)
+	shift := uint(from % naBitmapWordSize)
+	hasNA := false
+	for w := range sliced {
+		src := from/naBitmapWordSize + w
+		var word uint64
+		if src < len(b) {
+			word = b[src] >> shift
+		}
+		if shift != 0 && src+1 < len(b) {
+			word |= b[src+1] << (naBitmapWordSize - shift)
+		}
+		sliced[w] = word
+	}
+	if rest := length % naBitmapWordSize; rest != 0 {
+		sliced[len(sliced)-1] &= 1<<uint(rest) - 1
+	}
+
+	for _, word := range sliced {
+		if word != 0 {
+			hasNA = true
+			break
+		}
+	}
+	if !hasNA {
+		return nil
+	}
+
+	return sliced
+}
+
+// count returns the number of NA-values.
+func (b naBitmap) count() int {
+	count := 0
+	for _, word := range b {
+		count += bits.OnesCount64(word)
+	}
+
+	return count
+}
+
+// indices returns one-based indices of NA-values (if na is true) or of non-NA values (if na is false).
+func (b naBitmap) indices(length int, na bool) []int {
+	if b == nil {
+		if na {
+			return []int{}
+		}
+
+		return incIndices(indicesArray(length))
+	}
+
+	size := b.count()
+	if !na {
+		size = length - size
+	}
+
+	indices := make([]int, 0, size)
+	for w, word := range b {
+		if !na {
+			word = ^word
+		}
+
+		base := w * naBitmapWordSize
+		for word != 0 {
+			idx := base + bits.TrailingZeros64(word)
+			if idx >= length {
+				break
+			}
+			indices = append(indices, idx+1)
+			word &= word - 1
+		}
+	}
+
+	return indices
+}
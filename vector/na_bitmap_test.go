@@ -0,0 +1,257 @@
+package vector
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func boolsWithNAAt(length int, indices ...int) []bool {
+	na := make([]bool, length)
+	for _, idx := range indices {
+		na[idx] = true
+	}
+
+	return na
+}
+
+func TestNewNABitmap(t *testing.T) {
+	testData := []struct {
+		name   string
+		na     []bool
+		isNil  bool
+		count  int
+		naIdx  []int
+		valIdx []int
+	}{
+		{
+			name:   "nil",
+			na:     nil,
+			isNil:  true,
+			count:  0,
+			naIdx:  []int{},
+			valIdx: []int{},
+		},
+		{
+			name:   "without NA",
+			na:     []bool{false, false, false},
+			isNil:  true,
+			count:  0,
+			naIdx:  []int{},
+			valIdx: []int{1, 2, 3},
+		},
+		{
+			name:   "with NA",
+			na:     []bool{true, false, false, true, false},
+			isNil:  false,
+			count:  2,
+			naIdx:  []int{1, 4},
+			valIdx: []int{2, 3, 5},
+		},
+		{
+			name:   "several words",
+			na:     boolsWithNAAt(130, 0, 63, 64, 129),
+			isNil:  false,
+			count:  4,
+			naIdx:  []int{1, 64, 65, 130},
+			valIdx: append(incIndices(indicesArray(64))[1:63], incIndices(indicesArray(129))[65:]...),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			bitmap := newNABitmap(data.na)
+
+			if (bitmap == nil) != data.isNil {
+				t.Error(fmt.Sprintf("Bitmap nil (%t) is not equal to expected (%t)", bitmap == nil, data.isNil))
+			}
+			if bitmap.count() != data.count {
+				t.Error(fmt.Sprintf("Count (%d) is not equal to expected (%d)", bitmap.count(), data.count))
+			}
+			if !reflect.DeepEqual(bitmap.bools(len(data.na)), append([]bool{}, data.na...)) {
+				t.Error(fmt.Sprintf("Bools (%v) are not equal to expected (%v)", bitmap.bools(len(data.na)), data.na))
+			}
+			naIdx := bitmap.indices(len(data.na), true)
+			if !reflect.DeepEqual(naIdx, data.naIdx) {
+				t.Error(fmt.Sprintf("NA indices (%v) are not equal to expected (%v)", naIdx, data.naIdx))
+			}
+			valIdx := bitmap.indices(len(data.na), false)
+			if !reflect.DeepEqual(valIdx, data.valIdx) {
+				t.Error(fmt.Sprintf("Value indices (%v) are not equal to expected (%v)", valIdx, data.valIdx))
+			}
+		})
+	}
+}
+
+func TestNewFullNABitmap(t *testing.T) {
+	testData := []struct {
+		name   string
+		length int
+	}{
+		{name: "empty", length: 0},
+		{name: "partial word", length: 5},
+		{name: "full word", length: 64},
+		{name: "several words", length: 100},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			bitmap := newFullNABitmap(data.length)
+
+			if !reflect.DeepEqual(bitmap, newNABitmap(trueBooleanArr(data.length))) {
+				t.Error(fmt.Sprintf("Bitmap (%v) is not equal to packed NA-flags", bitmap))
+			}
+			if bitmap.count() != data.length {
+				t.Error(fmt.Sprintf("Count (%d) is not equal to length (%d)", bitmap.count(), data.length))
+			}
+		})
+	}
+}
+
+func TestNABitmap_Mask(t *testing.T) {
+	testData := []struct {
+		name string
+		na   []bool
+		in   []bool
+		val  bool
+		out  []bool
+	}{
+		{
+			name: "without NA",
+			na:   []bool{false, false, false},
+			in:   []bool{true, false, true},
+			val:  false,
+			out:  []bool{true, false, true},
+		},
+		{
+			name: "mask to false",
+			na:   []bool{true, false, true},
+			in:   []bool{true, true, true},
+			val:  false,
+			out:  []bool{false, true, false},
+		},
+		{
+			name: "mask to true",
+			na:   []bool{false, true, false},
+			in:   []bool{false, false, false},
+			val:  true,
+			out:  []bool{false, true, false},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			newNABitmap(data.na).mask(data.in, data.val)
+
+			if !reflect.DeepEqual(data.in, data.out) {
+				t.Error(fmt.Sprintf("Masked (%v) is not equal to expected (%v)", data.in, data.out))
+			}
+		})
+	}
+}
+
+func TestNABitmap_NAFreeHelpers(t *testing.T) {
+	testData := []struct {
+		name   string
+		result Payload
+		exp    Payload
+	}{
+		{
+			name:   "by indices",
+			result: FloatPayload([]float64{1, 2, 3}, nil).ByIndices([]int{3, 1}),
+			exp:    FloatPayload([]float64{3, 1}, nil),
+		},
+		{
+			name:   "by indices with zero index",
+			result: FloatPayload([]float64{1, 2, 3}, nil).ByIndices([]int{3, 0, 1}),
+			exp:    FloatPayload([]float64{3, 0, 1}, []bool{false, true, false}),
+		},
+		{
+			name:   "adjust to lesser size",
+			result: IntegerPayload([]int{1, 2, 3}, nil).Adjust(2),
+			exp:    IntegerPayload([]int{1, 2}, nil),
+		},
+		{
+			name:   "adjust to bigger size",
+			result: IntegerPayload([]int{1, 2}, nil).Adjust(5),
+			exp:    IntegerPayload([]int{1, 2, 1, 2, 1}, nil),
+		},
+		{
+			name:   "apply",
+			result: StringPayload([]string{"a", "b"}, nil).(Appliable).Apply(func(s string) string { return s + s }),
+			exp:    StringPayload([]string{"aa", "bb"}, nil),
+		},
+		{
+			name:   "apply to by value",
+			result: IntegerPayload([]int{1, 2, 3}, nil).(AppliableTo).ApplyTo([]int{2}, 10),
+			exp:    IntegerPayload([]int{1, 10, 3}, nil),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(New(data.result), New(data.exp)) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.exp))
+			}
+		})
+	}
+}
+
+func benchmarkPayloads(length int) map[string]Payload {
+	data := make([]float64, length)
+	na := make([]bool, length)
+	for i := range data {
+		data[i] = float64(i)
+		na[i] = i%10 == 0
+	}
+
+	return map[string]Payload{
+		"without NA": FloatPayload(data, nil),
+		"with NA":    FloatPayload(data, na),
+	}
+}
+
+func BenchmarkNABitmap_ByIndices(b *testing.B) {
+	indices := make([]int, 10000)
+	for i := range indices {
+		indices[i] = len(indices) - i
+	}
+
+	for name, payload := range benchmarkPayloads(len(indices)) {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				payload.ByIndices(indices)
+			}
+		})
+	}
+}
+
+func BenchmarkNABitmap_Adjust(b *testing.B) {
+	for name, payload := range benchmarkPayloads(10000) {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				payload.Adjust(25000)
+			}
+		})
+	}
+}
+
+func BenchmarkNABitmap_Apply(b *testing.B) {
+	for name, payload := range benchmarkPayloads(10000) {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				payload.(Appliable).Apply(func(f float64) float64 { return f * 2 })
+			}
+		})
+	}
+}
+
+func BenchmarkNABitmap_Which(b *testing.B) {
+	for name, payload := range benchmarkPayloads(10000) {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				payload.(Whichable).Which(func(f float64) bool { return f > 5000 })
+			}
+		})
+	}
+}
@@ -9,58 +9,48 @@ type NAble interface {
 	WithoutNA() []int
 }
 
-// DefNAble is an easy to embed implementation of NAble interface.
+// DefNAble is an easy to embed implementation of NAble interface. NA-values are stored in a bitmap, which is nil
+// if there are no NA-values at all.
 type DefNAble struct {
-	na []bool
+	na     naBitmap
+	length int
 }
 
-func (n *DefNAble) IsNA() []bool {
-	isna := make([]bool, len(n.na))
-	copy(isna, n.na)
+// newDefNAble creates DefNAble from NA-flags.
+func newDefNAble(na []bool) DefNAble {
+	return DefNAble{
+		na:     newNABitmap(na),
+		length: len(na),
+	}
+}
 
-	return isna
+// slice returns DefNAble for the elements from the given zero-based range.
+func (n *DefNAble) slice(from, to int) DefNAble {
+	return DefNAble{
+		na:     n.na.slice(from, to),
+		length: to - from,
+	}
 }
 
-func (n *DefNAble) NotNA() []bool {
-	notna := make([]bool, len(n.na))
+func (n *DefNAble) IsNA() []bool {
+	return n.na.bools(n.length)
+}
 
-	for i := 0; i < len(n.na); i++ {
-		notna[i] = !n.na[i]
-	}
+func (n *DefNAble) NotNA() []bool {
+	notna := trueBooleanArr(n.length)
+	n.na.mask(notna, false)
 
 	return notna
 }
 
 func (n *DefNAble) HasNA() bool {
-	for i := 0; i < len(n.na); i++ {
-		if n.na[i] == true {
-			return true
-		}
-	}
-
-	return false
+	return n.na != nil
 }
 
 func (n *DefNAble) WithNA() []int {
-	naIndices := make([]int, 0)
-
-	for i := 0; i < len(n.na); i++ {
-		if n.na[i] == true {
-			naIndices = append(naIndices, i+1)
-		}
-	}
-
-	return naIndices
+	return n.na.indices(n.length, true)
 }
 
 func (n *DefNAble) WithoutNA() []int {
-	naIndices := make([]int, 0)
-
-	for i := 0; i < len(n.na); i++ {
-		if n.na[i] == false {
-			naIndices = append(naIndices, i+1)
-		}
-	}
-
-	return naIndices
+	return n.na.indices(n.length, false)
 }
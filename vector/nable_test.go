@@ -33,9 +33,7 @@ func TestDefNAble_IsNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			nable := DefNAble{
-				na: data.in,
-			}
+			nable := newDefNAble(data.in)
 			na := nable.IsNA()
 			if !reflect.DeepEqual(na, data.out) {
 				t.Error(fmt.Sprintf("Value IsNA(%v) is not equal to out(%v)", na, data.out))
@@ -70,9 +68,7 @@ func TestDefNAble_NotNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			nable := DefNAble{
-				na: data.in,
-			}
+			nable := newDefNAble(data.in)
 			na := nable.NotNA()
 			if !reflect.DeepEqual(na, data.out) {
 				t.Error(fmt.Sprintf("Value IsNA(%v) is not equal to out(%v)", na, data.out))
@@ -111,9 +107,7 @@ func TestDefNAble_HasNA(t *testing.T) {
 
 	for index, data := range testData {
 		t.Run(fmt.Sprintf("Data %d", index), func(t *testing.T) {
-			nable := DefNAble{
-				na: data.na,
-			}
+			nable := newDefNAble(data.na)
 			if nable.HasNA() != data.expected {
 				t.Error(fmt.Sprintf("nable.HasNA() (%t) is not equal to data.expected (%t)", nable.HasNA(),
 					data.expected))
@@ -157,9 +151,7 @@ func TestDefNAble_OnlyNA(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			nable := DefNAble{
-				na: data.na,
-			}
+			nable := newDefNAble(data.na)
 			withNA := nable.WithNA()
 			if !reflect.DeepEqual(withNA, data.expected) {
 				t.Error(fmt.Sprintf("nable.OnlyNa() %v is not equal to data.expected %v", withNA,
@@ -204,9 +196,7 @@ func TestDefNAble_WithoutNA(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			nable := DefNAble{
-				na: data.na,
-			}
+			nable := newDefNAble(data.na)
 			withoutNA := nable.WithoutNA()
 			if !reflect.DeepEqual(withoutNA, data.expected) {
 				t.Error(fmt.Sprintf("nable.OnlyNa() %v is not equal to data.expected %v", withoutNA,
@@ -1,13 +1,21 @@
 package vector
 
 const (
-	PayloadTypeNA      = "na"
-	PayloadTypeBoolean = "boolean"
-	PayloadTypeInteger = "integer"
-	PayloadTypeFloat   = "float"
-	PayloadTypeString  = "string"
-	PayloadTypeVector  = "vector"
-	PayloadTypeComplex = "complex"
-	PayloadTypeAny     = "any"
-	PayloadTypeTime    = "time"
+	PayloadTypeNA       = "na"
+	PayloadTypeBoolean  = "boolean"
+	PayloadTypeInteger  = "integer"
+	PayloadTypeFloat    = "float"
+	PayloadTypeString   = "string"
+	PayloadTypeVector   = "vector"
+	PayloadTypeComplex  = "complex"
+	PayloadTypeAny      = "any"
+	PayloadTypeTime     = "time"
+	PayloadTypeFactor   = "factor"
+	PayloadTypeDate     = "date"
+	PayloadTypeDuration = "duration"
+	PayloadTypeDecimal  = "decimal"
+	PayloadTypeInt32    = "int32"
+	PayloadTypeInt64    = "int64"
+	PayloadTypeUint8    = "uint8"
+	PayloadTypeFloat32  = "float32"
 )
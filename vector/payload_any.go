@@ -68,6 +68,43 @@ func (p *anyPayload) ByIndices(indices []int) Payload {
 	return AnyPayload(data, na, p.Options()...)
 }
 
+// slice returns a payload sharing the data of the elements from the given zero-based range.
+func (p *anyPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+// initArrangeable sets up sorting of the payload with the comparison functions provided in the options.
+func (p *anyPayload) initArrangeable() {
+	fnLess := func(i, j int) bool {
+		return i < j
+	}
+	fnEqual := func(i, j int) bool {
+		return i == j
+	}
+
+	if p.fn.Lt != nil && p.fn.Eq != nil {
+		fnLess = func(i, j int) bool {
+			return p.fn.Lt(p.data[i], p.data[j])
+		}
+		fnEqual = func(i, j int) bool {
+			return p.fn.Eq(p.data[i], p.data[j])
+		}
+	}
+
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess:   fnLess,
+		FnEqual:  fnEqual,
+	}
+}
+
 // Find returns the index of the first occurrence of the given value. If the value is not found, -1 is returned.
 func (p *anyPayload) Find(needle any) int {
 	if p.fn.Eq == nil {
@@ -90,7 +127,7 @@ func (p *anyPayload) FindAll(needle any) []int {
 // StrForElem returns the string representation of the value at the given index. If the payload contains NA-values,
 // they will be represented as "NA".
 func (p *anyPayload) StrForElem(idx int) string {
-	if p.na[idx-1] {
+	if p.na.at(idx - 1) {
 		return "NA"
 	}
 
@@ -222,7 +259,7 @@ func (p *anyPayload) IsUnique() []bool {
 
 	naIdx := 0
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			if naIdx == 0 {
 				naIdx = i
 			}
@@ -287,7 +324,7 @@ func (p *anyPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*anyPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if intable, ok := payload.(Anyable); ok {
 		srcData, srcNA = intable.Anies()
 	} else {
@@ -298,12 +335,12 @@ func (p *anyPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -323,7 +360,7 @@ func (p *anyPayload) Integers() ([]int, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := 0, true
 		if p.convertors.Intabler != nil {
-			val, naVal = p.convertors.Intabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Intabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -345,7 +382,7 @@ func (p *anyPayload) Floats() ([]float64, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := math.NaN(), true
 		if p.convertors.Floatabler != nil {
-			val, naVal = p.convertors.Floatabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Floatabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -367,7 +404,7 @@ func (p *anyPayload) Complexes() ([]complex128, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := cmplx.NaN(), true
 		if p.convertors.Complexabler != nil {
-			val, naVal = p.convertors.Complexabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Complexabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -389,7 +426,7 @@ func (p *anyPayload) Booleans() ([]bool, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := false, true
 		if p.convertors.Boolabler != nil {
-			val, naVal = p.convertors.Boolabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Boolabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -411,7 +448,7 @@ func (p *anyPayload) Strings() ([]string, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := "", true
 		if p.convertors.Stringabler != nil {
-			val, naVal = p.convertors.Stringabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Stringabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -433,7 +470,7 @@ func (p *anyPayload) Times() ([]time.Time, []bool) {
 	for i := 0; i < p.length; i++ {
 		val, naVal := time.Time{}, true
 		if p.convertors.Timeabler != nil {
-			val, naVal = p.convertors.Timeabler(i+1, p.data[i], p.na[i])
+			val, naVal = p.convertors.Timeabler(i+1, p.data[i], p.na.at(i))
 		}
 		data[i] = val
 		na[i] = naVal
@@ -452,7 +489,7 @@ func (p *anyPayload) Anies() ([]any, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -475,7 +512,7 @@ func (p *anyPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return AnyPayload(newVals, newNA, p.Options()...)
@@ -561,38 +598,15 @@ func AnyPayload(data []any, na []bool, options ...Option) Payload {
 	}
 
 	payload := &anyPayload{
-		length:  length,
-		data:    vecData,
-		printer: nil,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		printer:  nil,
+		DefNAble: newDefNAble(vecNA),
 	}
 
 	conf.SetOptions(payload)
 
-	fnLess := func(i, j int) bool {
-		return i < j
-	}
-	fnEqual := func(i, j int) bool {
-		return i == j
-	}
-
-	if payload.fn.Lt != nil && payload.fn.Eq != nil {
-		fnLess = func(i, j int) bool {
-			return payload.fn.Lt(payload.data[i], payload.data[j])
-		}
-		fnEqual = func(i, j int) bool {
-			return payload.fn.Eq(payload.data[i], payload.data[j])
-		}
-	}
-
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess:   fnLess,
-		FnEqual:  fnEqual,
-	}
+	payload.initArrangeable()
 
 	return payload
 }
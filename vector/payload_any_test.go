@@ -57,7 +57,7 @@ func TestAny(t *testing.T) {
 				t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", v.length, length))
 			}
 
-			payload, ok := v.payload.(*anyPayload)
+			payload, ok := v.Payload().(*anyPayload)
 			if !ok {
 				t.Error("Payload is not anyPayload")
 			} else {
@@ -68,13 +68,13 @@ func TestAny(t *testing.T) {
 			}
 
 			if len(data.na) > 0 && len(data.na) == length {
-				if !reflect.DeepEqual(payload.na, data.na) {
+				if !reflect.DeepEqual(payload.IsNA(), data.na) {
 					t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-						payload.na, data.na))
+						payload.IsNA(), data.na))
 				}
 			} else if len(data.na) == 0 {
-				if !reflect.DeepEqual(payload.na, emptyNA) {
-					t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+				if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+					t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 				}
 			} else {
 				t.Error("error")
@@ -85,7 +85,7 @@ func TestAny(t *testing.T) {
 
 func testInterfaceEmpty(t *testing.T) {
 	vec := AnyWithNA([]any{1, 2, 3, 4, 5}, []bool{false, false, true, false})
-	naPayload, ok := vec.(*vector).payload.(*naPayload)
+	naPayload, ok := vec.(*vector).Payload().(*naPayload)
 	if !ok || naPayload.Len() > 0 {
 		t.Error("Vector's payload is not empty")
 	}
@@ -111,7 +111,7 @@ func TestAnyPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := AnyWithNA(data.in, nil).(*vector).payload
+			payload := AnyWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -156,11 +156,11 @@ func TestAnyPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*anyPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*anyPayload)
 			if !reflect.DeepEqual(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -195,7 +195,7 @@ func TestAnyPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := AnyWithNA([]any{1}, nil).(*vector).payload.(Whichable)
+	payload := AnyWithNA([]any{1}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -238,7 +238,7 @@ func TestAnyPayload_Which(t *testing.T) {
 		},
 	}
 
-	payload := AnyWithNA([]any{true, false, 1, false, true, 2.5, true, false, "true", false}, nil).(*vector).payload.(Whichable)
+	payload := AnyWithNA([]any{true, false, 1, false, true, 2.5, true, false, "true", false}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -332,7 +332,7 @@ func TestAnyPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := AnyWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := AnyWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*anyPayload)
@@ -340,9 +340,9 @@ func TestAnyPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						payloadOut.data, data.dataOut))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						payloadOut.na, data.naOut))
+						payloadOut.IsNA(), data.naOut))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -408,7 +408,7 @@ func TestAnyPayload_Integers(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Intabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.dataOut) {
@@ -475,7 +475,7 @@ func TestAnyPayload_Floats(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Floatabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			floats, na := payload.Floats()
 			if !util.EqualFloatArrays(floats, data.dataOut) {
@@ -544,7 +544,7 @@ func TestAnyPayload_Complexes(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Complexabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			complexes, na := payload.Complexes()
 			if !util.EqualComplexArrays(complexes, data.dataOut) {
@@ -611,7 +611,7 @@ func TestAnyPayload_Booleans(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Boolabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			bools, na := payload.Booleans()
 			if !reflect.DeepEqual(bools, data.dataOut) {
@@ -678,7 +678,7 @@ func TestAnyPayload_Strings(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Stringabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.dataOut) {
@@ -743,7 +743,7 @@ func TestAnyPayload_Times(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Timeabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			times, na := payload.Times()
 			if !reflect.DeepEqual(times, data.dataOut) {
@@ -802,7 +802,7 @@ func TestAnyPayload_Anies(t *testing.T) {
 		t.Run(data.name, func(t *testing.T) {
 			vec := AnyWithNA(data.dataIn, data.naIn,
 				OptionAnyConvertors(AnyConvertors{Intabler: data.convertor}))
-			payload := vec.(*vector).payload.(*anyPayload)
+			payload := vec.(*vector).Payload().(*anyPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.dataOut) {
@@ -833,7 +833,7 @@ func TestAnyPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := AnyWithNA([]any{}, nil).(*vector).payload.(Summarizable)
+	payload := AnyWithNA([]any{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -888,16 +888,16 @@ func TestAnyPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := AnyWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := AnyWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*anyPayload)
 			if !reflect.DeepEqual(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -940,9 +940,9 @@ func TestAnyPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -987,9 +987,9 @@ func TestAnyPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPayload.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPayload.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPayload.na))
+					outPayload.IsNA(), data.outPayload.IsNA()))
 			}
 		})
 	}
@@ -1141,9 +1141,9 @@ func TestAnyPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -1698,9 +1698,9 @@ func TestAnyPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
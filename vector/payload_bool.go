@@ -34,6 +34,29 @@ func (p *booleanPayload) ByIndices(indices []int) Payload {
 	return BooleanPayload(data, na, p.Options()...)
 }
 
+func (p *booleanPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *booleanPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return !p.data[i] && p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
 func (p *booleanPayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[bool](whicher)
 }
@@ -77,7 +100,7 @@ func (p *booleanPayload) Integers() ([]int, []bool) {
 
 	data := make([]int, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = 0
 		} else {
 			if p.data[i] {
@@ -89,7 +112,7 @@ func (p *booleanPayload) Integers() ([]int, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -102,7 +125,7 @@ func (p *booleanPayload) Floats() ([]float64, []bool) {
 	data := make([]float64, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = math.NaN()
 		} else {
 			if p.data[i] {
@@ -114,7 +137,7 @@ func (p *booleanPayload) Floats() ([]float64, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -126,7 +149,7 @@ func (p *booleanPayload) Complexes() ([]complex128, []bool) {
 
 	data := make([]complex128, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = cmplx.NaN()
 		} else {
 			if p.data[i] {
@@ -138,7 +161,7 @@ func (p *booleanPayload) Complexes() ([]complex128, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -152,7 +175,7 @@ func (p *booleanPayload) Booleans() ([]bool, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -169,7 +192,7 @@ func (p *booleanPayload) Strings() ([]string, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -181,7 +204,7 @@ func (p *booleanPayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -189,7 +212,7 @@ func (p *booleanPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -211,7 +234,7 @@ func (p *booleanPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return BooleanPayload(newVals, newNA)
@@ -248,7 +271,7 @@ func (p *booleanPayload) Groups() ([][]int, []any) {
 }
 
 func (p *booleanPayload) StrForElem(idx int) string {
-	if p.na[idx-1] {
+	if p.na.at(idx - 1) {
 		return "NA"
 	}
 
@@ -289,7 +312,7 @@ func (p *booleanPayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -325,7 +348,7 @@ func (p *booleanPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*booleanPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if boolable, ok := payload.(Boolable); ok {
 		srcData, srcNA = boolable.Booleans()
 	} else {
@@ -336,12 +359,12 @@ func (p *booleanPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -372,23 +395,12 @@ func BooleanPayload(data []bool, na []bool, _ ...Option) Payload {
 	}
 
 	payload := &booleanPayload{
-		length: length,
-		data:   vecData,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		DefNAble: newDefNAble(vecNA),
 	}
 
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess: func(i, j int) bool {
-			return !payload.data[i] && payload.data[j]
-		},
-		FnEqual: func(i, j int) bool {
-			return payload.data[i] == payload.data[j]
-		},
-	}
+	payload.initArrangeable()
 
 	return payload
 }
@@ -62,7 +62,7 @@ func TestBoolean(t *testing.T) {
 			vv := v.(*vector)
 
 			if data.isEmpty {
-				naPayload, ok := vv.payload.(*naPayload)
+				naPayload, ok := vv.Payload().(*naPayload)
 				if !ok || naPayload.Len() > 0 {
 					t.Error("Vector's payload is not empty")
 				}
@@ -72,7 +72,7 @@ func TestBoolean(t *testing.T) {
 					t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", vv.length, length))
 				}
 
-				payload, ok := vv.payload.(*booleanPayload)
+				payload, ok := vv.Payload().(*booleanPayload)
 				if !ok {
 					t.Error("Payload is not booleanPayload")
 				} else {
@@ -83,13 +83,13 @@ func TestBoolean(t *testing.T) {
 				}
 
 				if len(data.na) > 0 && len(data.na) == length {
-					if !reflect.DeepEqual(payload.na, data.na) {
+					if !reflect.DeepEqual(payload.IsNA(), data.na) {
 						t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-							payload.na[1:], data.na))
+							payload.IsNA()[1:], data.na))
 					}
 				} else if len(data.na) == 0 {
-					if !reflect.DeepEqual(payload.na, emptyNA) {
-						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+					if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 					}
 				} else {
 					t.Error("error")
@@ -119,7 +119,7 @@ func TestBooleanPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := BooleanWithNA(data.in, nil).(*vector).payload
+			payload := BooleanWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -158,7 +158,7 @@ func TestBooleanPayload_Booleans(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.out) {
@@ -201,7 +201,7 @@ func TestBooleanPayload_Integers(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.out) {
@@ -244,7 +244,7 @@ func TestBooleanPayload_Floats(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			floats, na := payload.Floats()
 			correct := true
@@ -297,7 +297,7 @@ func TestBooleanPayload_Complexes(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			complexes, na := payload.Complexes()
 			correct := true
@@ -350,7 +350,7 @@ func TestBooleanPayload_Strings(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.out) {
@@ -393,7 +393,7 @@ func TestBooleanPayload_Interfaces(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := BooleanWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*booleanPayload)
+			payload := vec.(*vector).Payload().(*booleanPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.out) {
@@ -442,11 +442,11 @@ func TestBooleanPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*booleanPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*booleanPayload)
 			if !reflect.DeepEqual(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -481,7 +481,7 @@ func TestBooleanPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := BooleanWithNA([]bool{true}, nil).(*vector).payload.(Whichable)
+	payload := BooleanWithNA([]bool{true}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -524,7 +524,7 @@ func TestBooleanPayload_Whicher(t *testing.T) {
 		},
 	}
 
-	payload := BooleanWithNA([]bool{true, false, true, false, true, false, true, false, true, false}, nil).(*vector).payload.(Whichable)
+	payload := BooleanWithNA([]bool{true, false, true, false, true, false, true, false, true, false}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -608,7 +608,7 @@ func TestBooleanPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := BooleanWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := BooleanWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*booleanPayload)
@@ -616,9 +616,9 @@ func TestBooleanPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -748,7 +748,7 @@ func TestBooleanPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := BooleanWithNA([]bool{}, nil).(*vector).payload.(Summarizable)
+	payload := BooleanWithNA([]bool{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -811,16 +811,16 @@ func TestBooleanPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := BooleanWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := BooleanWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*booleanPayload)
 			if !reflect.DeepEqual(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -863,9 +863,9 @@ func TestBooleanPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -910,9 +910,9 @@ func TestBooleanPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPaylout.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPaylout.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPaylout.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPaylout.na))
+					outPayload.IsNA(), data.outPaylout.IsNA()))
 			}
 		})
 	}
@@ -1141,9 +1141,9 @@ func TestBooleanPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1288,9 +1288,9 @@ func TestBooleanPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -39,6 +39,15 @@ func (p *complexPayload) ByIndices(indices []int) Payload {
 	return ComplexPayload(data, na, p.Options()...)
 }
 
+func (p *complexPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+
+	return &payload
+}
+
 func (p *complexPayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[complex128](whicher)
 }
@@ -82,7 +91,7 @@ func (p *complexPayload) Integers() ([]int, []bool) {
 
 	data := make([]int, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = 0
 		} else {
 			data[i] = int(real(p.data[i]))
@@ -90,7 +99,7 @@ func (p *complexPayload) Integers() ([]int, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -103,7 +112,7 @@ func (p *complexPayload) Floats() ([]float64, []bool) {
 	data := make([]float64, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = math.NaN()
 		} else {
 			data[i] = real(p.data[i])
@@ -111,7 +120,7 @@ func (p *complexPayload) Floats() ([]float64, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -125,7 +134,7 @@ func (p *complexPayload) Complexes() ([]complex128, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -138,7 +147,7 @@ func (p *complexPayload) Booleans() ([]bool, []bool) {
 	data := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = false
 		} else {
 			data[i] = p.data[i] != 0
@@ -146,7 +155,7 @@ func (p *complexPayload) Booleans() ([]bool, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -163,7 +172,7 @@ func (p *complexPayload) Strings() ([]string, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -175,7 +184,7 @@ func (p *complexPayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -183,7 +192,7 @@ func (p *complexPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -205,7 +214,7 @@ func (p *complexPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return ComplexPayload(newVals, newNA, p.Options()...)
@@ -261,7 +270,7 @@ func (p *complexPayload) Groups() ([][]int, []any) {
 func (p *complexPayload) StrForElem(idx int) string {
 	i := idx - 1
 
-	if p.na[i] {
+	if p.na.at(i) {
 		return "NA"
 	}
 
@@ -335,7 +344,7 @@ func (p *complexPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*complexPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if complexable, ok := payload.(Complexable); ok {
 		srcData, srcNA = complexable.Complexes()
 	} else {
@@ -346,12 +355,12 @@ func (p *complexPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -368,7 +377,7 @@ func (p *complexPayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -428,12 +437,10 @@ func ComplexPayload(data []complex128, na []bool, options ...Option) Payload {
 	}
 
 	payload := &complexPayload{
-		length:  length,
-		data:    vecData,
-		printer: printer,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		printer:  printer,
+		DefNAble: newDefNAble(vecNA),
 	}
 	conf.SetOptions(payload)
 
@@ -20,7 +20,7 @@ func (p *complexPayload) Mean() Payload {
 
 	if p.length != 0 {
 		for i := 0; i < p.length; i++ {
-			if p.na[i] {
+			if p.na.at(i) {
 				mean, na = 0, true
 				goto outOfLoop
 			}
@@ -39,9 +39,9 @@ func TestComplexPayload_Sum(t *testing.T) {
 					sumPayload.data, data.sumData))
 			}
 
-			if !reflect.DeepEqual(sumPayload.na, data.sumNA) {
+			if !reflect.DeepEqual(sumPayload.IsNA(), data.sumNA) {
 				t.Error(fmt.Sprintf("Sum data (%v) is not equal to expected (%v)",
-					sumPayload.na, data.sumNA))
+					sumPayload.IsNA(), data.sumNA))
 			}
 		})
 	}
@@ -78,9 +78,9 @@ func TestComplexPayload_Mean(t *testing.T) {
 					payload.data, data.data))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.na) {
+			if !reflect.DeepEqual(payload.IsNA(), data.na) {
 				t.Error(fmt.Sprintf("Sum data (%v) is not equal to expected (%v)",
-					payload.na, data.na))
+					payload.IsNA(), data.na))
 			}
 		})
 	}
@@ -129,9 +129,9 @@ func TestComplexPayload_Prod(t *testing.T) {
 					payload.data, data.data))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.na) {
+			if !reflect.DeepEqual(payload.IsNA(), data.na) {
 				t.Error(fmt.Sprintf("Prod data (%v) is not equal to expected (%v)",
-					payload.na, data.na))
+					payload.IsNA(), data.na))
 			}
 		})
 	}
@@ -180,9 +180,9 @@ func TestComplexPayload_CumSum(t *testing.T) {
 					payload.data, data.data))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.na) {
+			if !reflect.DeepEqual(payload.IsNA(), data.na) {
 				t.Error(fmt.Sprintf("Prod data (%v) is not equal to expected (%v)",
-					payload.na, data.na))
+					payload.IsNA(), data.na))
 			}
 		})
 	}
@@ -231,9 +231,9 @@ func TestComplexPayload_CumProd(t *testing.T) {
 					payload.data, data.data))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.na) {
+			if !reflect.DeepEqual(payload.IsNA(), data.na) {
 				t.Error(fmt.Sprintf("Prod data (%v) is not equal to expected (%v)",
-					payload.na, data.na))
+					payload.IsNA(), data.na))
 			}
 		})
 	}
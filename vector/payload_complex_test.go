@@ -63,7 +63,7 @@ func TestComplex(t *testing.T) {
 			vv := v.(*vector)
 
 			if data.isEmpty {
-				naPayload, ok := vv.payload.(*naPayload)
+				naPayload, ok := vv.Payload().(*naPayload)
 				if !ok || naPayload.Len() > 0 {
 					t.Error("Vector's payload is not empty")
 				}
@@ -73,7 +73,7 @@ func TestComplex(t *testing.T) {
 					t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", vv.length, length))
 				}
 
-				payload, ok := vv.payload.(*complexPayload)
+				payload, ok := vv.Payload().(*complexPayload)
 				if !ok {
 					t.Error("Payload is not complexPayload")
 				} else {
@@ -84,13 +84,13 @@ func TestComplex(t *testing.T) {
 				}
 
 				if len(data.na) > 0 && len(data.na) == length {
-					if !reflect.DeepEqual(payload.na, data.na) {
+					if !reflect.DeepEqual(payload.IsNA(), data.na) {
 						t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-							payload.na, data.na))
+							payload.IsNA(), data.na))
 					}
 				} else if len(data.na) == 0 {
-					if !reflect.DeepEqual(payload.na, emptyNA) {
-						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+					if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 					}
 				} else {
 					t.Error("error")
@@ -120,7 +120,7 @@ func TestComplexPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := FloatWithNA(data.in, nil).(*vector).payload
+			payload := FloatWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -165,11 +165,11 @@ func TestComplexPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*complexPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*complexPayload)
 			if !util.EqualComplexArrays(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -204,7 +204,7 @@ func TestComplexPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := ComplexWithNA([]complex128{1}, nil).(*vector).payload.(Whichable)
+	payload := ComplexWithNA([]complex128{1}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -247,7 +247,7 @@ func TestComplexPayload_Whicher(t *testing.T) {
 		},
 	}
 
-	payload := ComplexWithNA([]complex128{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).payload.(Whichable)
+	payload := ComplexWithNA([]complex128{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -329,7 +329,7 @@ func TestComplexPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := ComplexWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := ComplexWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*complexPayload)
@@ -337,9 +337,9 @@ func TestComplexPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						payloadOut.data, data.dataOut))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						payloadOut.na, data.naOut))
+						payloadOut.IsNA(), data.naOut))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -381,7 +381,7 @@ func TestComplexPayload_Booleans(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.out) {
@@ -424,7 +424,7 @@ func TestComplexPayload_Integers(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.out) {
@@ -467,7 +467,7 @@ func TestComplexPayload_Interfaces(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.out) {
@@ -510,7 +510,7 @@ func TestComplexPayload_Floats(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			floats, na := payload.Floats()
 			if !util.EqualFloatArrays(floats, data.out) {
@@ -553,7 +553,7 @@ func TestComplexPayload_Complexes(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			complexes, na := payload.Complexes()
 			if !util.EqualComplexArrays(complexes, data.out) {
@@ -597,7 +597,7 @@ func TestComplexPayload_Strings(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := ComplexWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*complexPayload)
+			payload := vec.(*vector).Payload().(*complexPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.out) {
@@ -628,7 +628,7 @@ func TestComplexPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := ComplexWithNA([]complex128{}, nil).(*vector).payload.(Summarizable)
+	payload := ComplexWithNA([]complex128{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -683,16 +683,16 @@ func TestComplexPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := ComplexWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := ComplexWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*complexPayload)
 			if !util.EqualComplexArrays(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -735,9 +735,9 @@ func TestComplexPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -783,9 +783,9 @@ func TestComplexPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPaylout.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPaylout.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPaylout.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPaylout.na))
+					outPayload.IsNA(), data.outPaylout.IsNA()))
 			}
 		})
 	}
@@ -1061,9 +1061,9 @@ func TestComplexPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1211,9 +1211,9 @@ func TestComplexPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -0,0 +1,434 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultDateFormat is a format used for conversion between dates and strings by default.
+const DefaultDateFormat = "2006-01-02"
+
+const secondsInDay = 24 * 60 * 60
+
+// datePayload stores calendar dates without time and time zone as a number of days since the Unix epoch.
+type datePayload struct {
+	length int
+	data   []int
+	format string
+	DefNAble
+	DefArrangeable
+}
+
+func (p *datePayload) Type() string {
+	return PayloadTypeDate
+}
+
+func (p *datePayload) Len() int {
+	return p.length
+}
+
+func (p *datePayload) Pick(idx int) any {
+	if idx < 1 || idx > p.length || p.na.at(idx-1) {
+		return nil
+	}
+
+	return daysToTime(p.data[idx-1])
+}
+
+func (p *datePayload) Data() []any {
+	dates, _ := p.Dates()
+
+	return dataWithNAToInterfaceArray(dates, p.na)
+}
+
+func (p *datePayload) ByIndices(indices []int) Payload {
+	data, na := byIndicesWithNA(indices, p.data, p.na, 0)
+
+	return datePayloadFromDays(data, na, p.Options()...)
+}
+
+func (p *datePayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *datePayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
+func (p *datePayload) SupportsWhicher(whicher any) bool {
+	return supportsWhicherWithNA[time.Time](whicher)
+}
+
+func (p *datePayload) Which(whicher any) []bool {
+	dates, _ := p.Dates()
+
+	return whichWithNA(dates, p.na, whicher)
+}
+
+func (p *datePayload) Apply(applier any) Payload {
+	dates, _ := p.Dates()
+
+	return applyWithNA(dates, p.na, applier, p.Options())
+}
+
+func (p *datePayload) ApplyTo(indices []int, applier any) Payload {
+	dates, _ := p.Dates()
+
+	data, na := applyToWithNA(indices, dates, p.na, applier, time.Time{})
+	if data == nil {
+		return NAPayload(p.length)
+	}
+
+	return DatePayload(data, na, p.Options()...)
+}
+
+func (p *datePayload) Traverse(traverser any) {
+	dates, _ := p.Dates()
+
+	traverseWithNA(dates, p.na, traverser)
+}
+
+// Integers returns the number of days since the Unix epoch.
+func (p *datePayload) Integers() ([]int, []bool) {
+	if p.length == 0 {
+		return []int{}, []bool{}
+	}
+
+	data := make([]int, p.length)
+	copy(data, p.data)
+
+	return data, p.IsNA()
+}
+
+// Floats returns the number of days since the Unix epoch. NA-values are NaN.
+func (p *datePayload) Floats() ([]float64, []bool) {
+	if p.length == 0 {
+		return []float64{}, []bool{}
+	}
+
+	data := make([]float64, p.length)
+	for i, days := range p.data {
+		if p.na.at(i) {
+			data[i] = math.NaN()
+		} else {
+			data[i] = float64(days)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *datePayload) Strings() ([]string, []bool) {
+	if p.length == 0 {
+		return []string{}, []bool{}
+	}
+
+	data := make([]string, p.length)
+	for i := 0; i < p.length; i++ {
+		if !p.na.at(i) {
+			data[i] = p.StrForElem(i + 1)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+// Times returns dates as times at midnight UTC.
+func (p *datePayload) Times() ([]time.Time, []bool) {
+	return p.Dates()
+}
+
+func (p *datePayload) Dates() ([]time.Time, []bool) {
+	if p.length == 0 {
+		return []time.Time{}, []bool{}
+	}
+
+	data := make([]time.Time, p.length)
+	for i, days := range p.data {
+		if !p.na.at(i) {
+			data[i] = daysToTime(days)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *datePayload) Anies() ([]any, []bool) {
+	if p.length == 0 {
+		return []any{}, []bool{}
+	}
+
+	return p.Data(), p.IsNA()
+}
+
+func (p *datePayload) Append(payload Payload) Payload {
+	length := p.length + payload.Len()
+
+	var vals []time.Time
+	var na []bool
+
+	if dateable, ok := payload.(Dateable); ok {
+		vals, na = dateable.Dates()
+	} else if timeable, ok := payload.(Timeable); ok {
+		vals, na = timeable.Times()
+	} else {
+		vals, na = NAPayload(payload.Len()).(Dateable).Dates()
+	}
+
+	newVals := make([]int, length)
+	newNA := make([]bool, length)
+
+	copy(newVals, p.data)
+	p.na.copyTo(newNA)
+	copy(newNA[p.length:], na)
+	for i, val := range vals {
+		if !na[i] {
+			newVals[p.length+i] = timeToDays(val)
+		}
+	}
+
+	return datePayloadFromDays(newVals, newNA, p.Options()...)
+}
+
+func (p *datePayload) Adjust(size int) Payload {
+	if size < p.length {
+		data, na := adjustToLesserSizeWithNA(p.data, p.na, size)
+
+		return datePayloadFromDays(data, na, p.Options()...)
+	}
+
+	if size > p.length {
+		data, na := adjustToBiggerSizeWithNA(p.data, p.na, p.length, size)
+
+		return datePayloadFromDays(data, na, p.Options()...)
+	}
+
+	return p
+}
+
+func (p *datePayload) StrForElem(idx int) string {
+	if p.na.at(idx - 1) {
+		return "NA"
+	}
+
+	return daysToTime(p.data[idx-1]).Format(p.format)
+}
+
+func (p *datePayload) Groups() ([][]int, []any) {
+	groups, values := groupsForData(p.data, p.na)
+
+	for i, val := range values {
+		if val != nil {
+			values[i] = daysToTime(val.(int))
+		}
+	}
+
+	return groups, values
+}
+
+/* Finder interface */
+
+func (p *datePayload) Find(needle any) int {
+	return find(needle, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) FindAll(needle any) []int {
+	return findAll(needle, p.data, p.na, p.convertComparator)
+}
+
+/* Ordered interface */
+
+func (p *datePayload) Eq(val any) []bool {
+	return eq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) Neq(val any) []bool {
+	return neq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) Gt(val any) []bool {
+	return gt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) Lt(val any) []bool {
+	return lt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) Gte(val any) []bool {
+	return gte(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *datePayload) Lte(val any) []bool {
+	return lte(val, p.data, p.na, p.convertComparator)
+}
+
+// convertComparator converts a time to the number of days of its calendar date.
+func (p *datePayload) convertComparator(val any) (int, bool) {
+	v, ok := val.(time.Time)
+	if !ok {
+		return 0, false
+	}
+
+	return timeToDays(v), true
+}
+
+func (p *datePayload) IsUnique() []bool {
+	booleans := make([]bool, p.length)
+
+	valuesMap := map[int]bool{}
+	wasNA := false
+	for i := 0; i < p.length; i++ {
+		is := false
+
+		if p.na.at(i) {
+			if !wasNA {
+				is = true
+				wasNA = true
+			}
+		} else {
+			if _, ok := valuesMap[p.data[i]]; !ok {
+				is = true
+				valuesMap[p.data[i]] = true
+			}
+		}
+
+		booleans[i] = is
+	}
+
+	return booleans
+}
+
+func (p *datePayload) Coalesce(payload Payload) Payload {
+	if p.length != payload.Len() {
+		payload = payload.Adjust(p.length)
+	}
+
+	var srcData []time.Time
+	var srcNA []bool
+
+	if dateable, ok := payload.(Dateable); ok {
+		srcData, srcNA = dateable.Dates()
+	} else if timeable, ok := payload.(Timeable); ok {
+		srcData, srcNA = timeable.Times()
+	} else {
+		return p
+	}
+
+	dstData := make([]int, p.length)
+	dstNA := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) && !srcNA[i] {
+			dstData[i] = timeToDays(srcData[i])
+			dstNA[i] = false
+		} else {
+			dstData[i] = p.data[i]
+			dstNA[i] = p.na.at(i)
+		}
+	}
+
+	return datePayloadFromDays(dstData, dstNA, p.Options()...)
+}
+
+func (p *datePayload) Options() []Option {
+	return []Option{
+		ConfOption{keyOptionDateFormat, p.format},
+	}
+}
+
+func (p *datePayload) SetOption(name string, val any) bool {
+	switch name {
+	case keyOptionDateFormat:
+		p.format = val.(string)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// timeToDays returns the number of days since the Unix epoch for the calendar date of the time in its location.
+func timeToDays(t time.Time) int {
+	year, month, day := t.Date()
+
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsInDay)
+}
+
+// daysToTime returns midnight UTC of the date which is days after the Unix epoch.
+func daysToTime(days int) time.Time {
+	return time.Unix(int64(days)*secondsInDay, 0).UTC()
+}
+
+func datePayloadFromDays(data []int, na []bool, options ...Option) Payload {
+	length := len(data)
+	conf := MergeOptions(options)
+
+	vecNA := make([]bool, length)
+	if len(na) > 0 {
+		if len(na) == length {
+			copy(vecNA, na)
+		} else {
+			emp := NAPayload(0)
+			return emp
+		}
+	}
+
+	vecData := make([]int, length)
+	for i := 0; i < length; i++ {
+		if !vecNA[i] {
+			vecData[i] = data[i]
+		}
+	}
+
+	payload := &datePayload{
+		length:   length,
+		data:     vecData,
+		format:   DefaultDateFormat,
+		DefNAble: newDefNAble(vecNA),
+	}
+
+	conf.SetOptions(payload)
+
+	payload.initArrangeable()
+
+	return payload
+}
+
+// DatePayload creates a payload with calendar dates. Only the date part of every time (in its own location) is
+// kept, so time of the day and time zone are dropped.
+//
+// Available options are:
+//   - OptionDateFormat(format string) - sets a date format for conversion to string.
+func DatePayload(data []time.Time, na []bool, options ...Option) Payload {
+	days := make([]int, len(data))
+	for i, val := range data {
+		days[i] = timeToDays(val)
+	}
+
+	return datePayloadFromDays(days, na, options...)
+}
+
+// DateWithNA creates a vector with DatePayload and allows to set NA-values.
+func DateWithNA(data []time.Time, na []bool, options ...Option) Vector {
+	return New(DatePayload(data, na, options...), options...)
+}
+
+// Date creates a vector with DatePayload.
+func Date(data []time.Time, options ...Option) Vector {
+	return DateWithNA(data, nil, options...)
+}
@@ -0,0 +1,88 @@
+package vector
+
+import (
+	"time"
+)
+
+// Add adds a number of days to the dates.
+func (p *datePayload) Add(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	addDays, addNA, ok := p.daysOf(p2)
+	if !ok {
+		return NAPayload(p.length)
+	}
+
+	days := make([]int, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || addNA[i] {
+			na[i] = true
+		} else {
+			days[i] = p.data[i] + addDays[i]
+		}
+	}
+
+	return datePayloadFromDays(days, na, p.Options()...)
+}
+
+// Sub subtracts a number of days from the dates. If another payload contains dates, durations between the dates
+// are returned.
+func (p *datePayload) Sub(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	if dates, ok := p2.(*datePayload); ok {
+		durations := make([]time.Duration, p.length)
+		na := make([]bool, p.length)
+
+		for i := 0; i < p.length; i++ {
+			if p.na.at(i) || dates.na.at(i) {
+				na[i] = true
+			} else {
+				durations[i] = time.Duration(p.data[i]-dates.data[i]) * secondsInDay * time.Second
+			}
+		}
+
+		return DurationPayload(durations, na)
+	}
+
+	subDays, subNA, ok := p.daysOf(p2)
+	if !ok {
+		return NAPayload(p.length)
+	}
+
+	days := make([]int, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || subNA[i] {
+			na[i] = true
+		} else {
+			days[i] = p.data[i] - subDays[i]
+		}
+	}
+
+	return datePayloadFromDays(days, na, p.Options()...)
+}
+
+// daysOf returns values of the payload as a number of days. Only numeric payloads are accepted.
+func (p *datePayload) daysOf(payload Payload) ([]int, []bool, bool) {
+	switch payload.(type) {
+	case *datePayload, *timePayload, *durationPayload:
+		return nil, nil, false
+	}
+
+	intable, ok := payload.(Intable)
+	if !ok {
+		return nil, nil, false
+	}
+
+	days, na := intable.Integers()
+
+	return days, na, true
+}
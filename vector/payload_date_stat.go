@@ -0,0 +1,21 @@
+package vector
+
+func (p *datePayload) Max() Payload {
+	if p.length == 0 || p.HasNA() {
+		return datePayloadFromDays([]int{0}, []bool{true}, p.Options()...)
+	}
+
+	max, _ := genMax(p.data, p.na)
+
+	return datePayloadFromDays([]int{max}, []bool{false}, p.Options()...)
+}
+
+func (p *datePayload) Min() Payload {
+	if p.length == 0 || p.HasNA() {
+		return datePayloadFromDays([]int{0}, []bool{true}, p.Options()...)
+	}
+
+	min, _ := genMin(p.data, p.na)
+
+	return datePayloadFromDays([]int{min}, []bool{false}, p.Options()...)
+}
@@ -0,0 +1,291 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	testData := []struct {
+		name    string
+		data    []time.Time
+		na      []bool
+		days    []int
+		outNA   []bool
+		isEmpty bool
+	}{
+		{
+			name: "normal",
+			data: []time.Time{
+				time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 3, 15, 23, 30, 0, 0, time.UTC),
+				time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC),
+			},
+			days:  []int{0, 19066, -1},
+			outNA: []bool{false, false, false},
+		},
+		{
+			name:  "date in its location",
+			data:  []time.Time{time.Date(2022, 3, 16, 1, 0, 0, 0, moscow)},
+			days:  []int{19067},
+			outNA: []bool{false},
+		},
+		{
+			name:  "with na",
+			data:  []time.Time{time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC), {}},
+			na:    []bool{false, true},
+			days:  []int{19066, 0},
+			outNA: []bool{false, true},
+		},
+		{
+			name:    "incorrect sized na",
+			data:    []time.Time{{}, {}},
+			na:      []bool{false},
+			isEmpty: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			vec := DateWithNA(data.data, data.na)
+
+			if data.isEmpty {
+				if !vec.IsEmpty() {
+					t.Error("Vector is not empty")
+				}
+				return
+			}
+
+			payload, ok := vec.(*vector).Payload().(*datePayload)
+			if !ok {
+				t.Error("Payload is not datePayload")
+				return
+			}
+
+			if !reflect.DeepEqual(payload.data, data.days) {
+				t.Error(fmt.Sprintf("Days (%v) are not equal to expected (%v)", payload.data, data.days))
+			}
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+				t.Error(fmt.Sprintf("NA (%v) are not equal to expected (%v)", payload.IsNA(), data.outNA))
+			}
+		})
+	}
+}
+
+func TestDatePayload_Conversions(t *testing.T) {
+	vec := DateWithNA([]time.Time{time.Date(2022, 3, 15, 10, 0, 0, 0, time.UTC), {}}, []bool{false, true},
+		OptionDateFormat("02.01.2006"))
+
+	strings, na := vec.Strings()
+	if !reflect.DeepEqual(strings, []string{"15.03.2022", ""}) || !reflect.DeepEqual(na, []bool{false, true}) {
+		t.Error(fmt.Sprintf("Strings (%v, %v) are not equal to expected", strings, na))
+	}
+
+	times, _ := vec.Times()
+	if !times[0].Equal(time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error(fmt.Sprintf("Times (%v) are not equal to expected", times))
+	}
+
+	integers, _ := vec.Integers()
+	if integers[0] != 19066 {
+		t.Error(fmt.Sprintf("Integers (%v) are not equal to expected", integers))
+	}
+
+	floats, _ := vec.Floats()
+	if floats[0] != 19066 || !math.IsNaN(floats[1]) {
+		t.Error(fmt.Sprintf("Floats (%v) are not equal to expected", floats))
+	}
+
+	if vec.StrForElem(2) != "NA" || vec.Type() != PayloadTypeDate {
+		t.Error("StrForElem() or Type() returned wrong values")
+	}
+}
+
+func TestVector_AsDate(t *testing.T) {
+	testData := []struct {
+		name string
+		vec  Vector
+		out  Vector
+	}{
+		{
+			name: "string",
+			vec:  String([]string{"2022-03-15", "bad"}),
+			out: DateWithNA([]time.Time{time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC), {}},
+				[]bool{false, true}),
+		},
+		{
+			name: "string with format",
+			vec:  String([]string{"15.03.2022"}, OptionDateFormat("02.01.2006")),
+			out:  Date([]time.Time{time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)}),
+		},
+		{
+			name: "time",
+			vec:  Time([]time.Time{time.Date(2022, 3, 15, 18, 45, 0, 0, time.UTC)}),
+			out:  Date([]time.Time{time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)}),
+		},
+		{
+			name: "boolean",
+			vec:  Boolean([]bool{true}),
+			out:  NA(1),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			out := data.vec.AsDate()
+			if !CompareVectorsForTest(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestDatePayload_Compare(t *testing.T) {
+	vec := Date([]time.Time{
+		time.Date(2022, 3, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 16, 0, 0, 0, 0, time.UTC),
+	})
+	val := time.Date(2022, 3, 15, 13, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name string
+		out  []bool
+		exp  []bool
+	}{
+		{name: "eq", out: vec.Eq(val), exp: []bool{false, true, false}},
+		{name: "neq", out: vec.Neq(val), exp: []bool{true, false, true}},
+		{name: "gt", out: vec.Gt(val), exp: []bool{false, false, true}},
+		{name: "lt", out: vec.Lt(val), exp: []bool{true, false, false}},
+		{name: "gte", out: vec.Gte(val), exp: []bool{false, true, true}},
+		{name: "lte", out: vec.Lte(val), exp: []bool{true, true, false}},
+		{name: "eq of wrong type", out: vec.Eq("2022-03-15"), exp: []bool{false, false, false}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestDatePayload_Groups(t *testing.T) {
+	vec := DateWithNA([]time.Time{
+		time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC),
+		{},
+		time.Date(2022, 3, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 15, 10, 0, 0, 0, time.UTC),
+	}, []bool{false, true, false, false})
+
+	groups, values := vec.Groups()
+	if !reflect.DeepEqual(groups, [][]int{{1, 4}, {3}, {2}}) {
+		t.Error(fmt.Sprintf("Groups (%v) are not equal to expected", groups))
+	}
+
+	expectedValues := []any{
+		time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 14, 0, 0, 0, 0, time.UTC),
+		nil,
+	}
+	if !reflect.DeepEqual(values, expectedValues) {
+		t.Error(fmt.Sprintf("Values (%v) are not equal to expected (%v)", values, expectedValues))
+	}
+
+	if !reflect.DeepEqual(vec.SortedIndices(), []int{3, 1, 4, 2}) {
+		t.Error(fmt.Sprintf("Sorted indices (%v) are not equal to expected", vec.SortedIndices()))
+	}
+}
+
+func TestDatePayload_Arithmetics(t *testing.T) {
+	vec := DateWithNA([]time.Time{
+		time.Date(2022, 2, 27, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+		{},
+	}, []bool{false, false, true})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "add days",
+			out:  vec.Add(Integer([]int{2, -1, 1})),
+			exp: DateWithNA([]time.Time{
+				time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC),
+				{},
+			}, []bool{false, false, true}),
+		},
+		{
+			name: "add dates to days",
+			out:  Integer([]int{2, -1, 1}).Add(vec),
+			exp: DateWithNA([]time.Time{
+				time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC),
+				{},
+			}, []bool{false, false, true}),
+		},
+		{
+			name: "add dates to int32 days",
+			out:  Int32([]int32{2, -1, 1}).Add(vec),
+			exp: DateWithNA([]time.Time{
+				time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC),
+				{},
+			}, []bool{false, false, true}),
+		},
+		{
+			name: "sub days",
+			out:  vec.Sub(Integer([]int{30})),
+			exp: DateWithNA([]time.Time{
+				time.Date(2022, 1, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 30, 0, 0, 0, 0, time.UTC),
+				{},
+			}, []bool{false, false, true}),
+		},
+		{
+			name: "sub dates",
+			out:  vec.Sub(Date([]time.Time{time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC)})),
+			exp:  DurationWithNA([]time.Duration{-24 * time.Hour, 24 * time.Hour, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "add duration",
+			out:  vec.Add(Duration([]time.Duration{time.Hour})),
+			exp:  NA(3),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestDatePayload_MaxMin(t *testing.T) {
+	vec := Date([]time.Time{
+		time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	max := Date([]time.Time{time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)})
+	if !CompareVectorsForTest(vec.Max(), max) {
+		t.Error(fmt.Sprintf("Max (%v) is not equal to expected (%v)", vec.Max(), max))
+	}
+
+	min := Date([]time.Time{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if !CompareVectorsForTest(vec.Min(), min) {
+		t.Error(fmt.Sprintf("Min (%v) is not equal to expected (%v)", vec.Min(), min))
+	}
+}
@@ -0,0 +1,620 @@
+package vector
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"strings"
+)
+
+const (
+	DecimalRoundHalfUp   = "half_up"
+	DecimalRoundHalfEven = "half_even"
+	DecimalRoundDown     = "down"
+	DecimalRoundUp       = "up"
+	DecimalRoundFloor    = "floor"
+	DecimalRoundCeiling  = "ceiling"
+)
+
+// DefaultDecimalScale is a number of digits after the decimal point used by default.
+const DefaultDecimalScale = 2
+
+// decimalPayload stores fixed-precision decimal numbers as integers scaled by 10^scale, so 12.34 with scale 2 is
+// stored as 1234. All operations are exact, results which do not fit into the scale are rounded by the rounding mode
+// of the payload. Results which overflow int64 become NA.
+type decimalPayload struct {
+	length   int
+	data     []int64
+	scale    int
+	rounding string
+	DefNAble
+	DefArrangeable
+}
+
+func (p *decimalPayload) Type() string {
+	return PayloadTypeDecimal
+}
+
+func (p *decimalPayload) Len() int {
+	return p.length
+}
+
+func (p *decimalPayload) Pick(idx int) any {
+	if idx < 1 || idx > p.length || p.na.at(idx-1) {
+		return nil
+	}
+
+	return formatDecimal(p.data[idx-1], p.scale)
+}
+
+func (p *decimalPayload) Data() []any {
+	values, _ := p.Strings()
+
+	return dataWithNAToInterfaceArray(values, p.na)
+}
+
+func (p *decimalPayload) ByIndices(indices []int) Payload {
+	data, na := byIndicesWithNA(indices, p.data, p.na, 0)
+
+	return DecimalPayload(data, na, p.Options()...)
+}
+
+func (p *decimalPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *decimalPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
+func (p *decimalPayload) SupportsWhicher(whicher any) bool {
+	return supportsWhicherWithNA[string](whicher)
+}
+
+func (p *decimalPayload) Which(whicher any) []bool {
+	values, _ := p.Strings()
+
+	return whichWithNA(values, p.na, whicher)
+}
+
+func (p *decimalPayload) Traverse(traverser any) {
+	values, _ := p.Strings()
+
+	traverseWithNA(values, p.na, traverser)
+}
+
+func (p *decimalPayload) Integers() ([]int, []bool) {
+	if p.length == 0 {
+		return []int{}, []bool{}
+	}
+
+	data := make([]int, p.length)
+	na := p.IsNA()
+	for i, val := range p.data {
+		if na[i] {
+			continue
+		}
+
+		rounded, ok := roundBig(big.NewInt(val), pow10(p.scale), p.rounding)
+		if ok {
+			data[i] = int(rounded)
+		} else {
+			na[i] = true
+		}
+	}
+
+	return data, na
+}
+
+func (p *decimalPayload) Floats() ([]float64, []bool) {
+	if p.length == 0 {
+		return []float64{}, []bool{}
+	}
+
+	data := make([]float64, p.length)
+	for i, val := range p.data {
+		if p.na.at(i) {
+			data[i] = math.NaN()
+		} else {
+			data[i], _ = new(big.Rat).SetFrac(big.NewInt(val), pow10(p.scale)).Float64()
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *decimalPayload) Complexes() ([]complex128, []bool) {
+	if p.length == 0 {
+		return []complex128{}, []bool{}
+	}
+
+	floats, na := p.Floats()
+
+	data := make([]complex128, p.length)
+	for i, val := range floats {
+		if na[i] {
+			data[i] = cmplx.NaN()
+		} else {
+			data[i] = complex(val, 0)
+		}
+	}
+
+	return data, na
+}
+
+func (p *decimalPayload) Strings() ([]string, []bool) {
+	if p.length == 0 {
+		return []string{}, []bool{}
+	}
+
+	data := make([]string, p.length)
+	for i, val := range p.data {
+		if !p.na.at(i) {
+			data[i] = formatDecimal(val, p.scale)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *decimalPayload) Anies() ([]any, []bool) {
+	if p.length == 0 {
+		return []any{}, []bool{}
+	}
+
+	return p.Data(), p.IsNA()
+}
+
+// Decimals returns the values scaled to the provided scale.
+func (p *decimalPayload) Decimals(scale int, rounding string) ([]int64, []bool) {
+	if p.length == 0 {
+		return []int64{}, []bool{}
+	}
+
+	if scale == p.scale {
+		data := make([]int64, p.length)
+		copy(data, p.data)
+
+		return data, p.IsNA()
+	}
+
+	data := make([]int64, p.length)
+	na := p.IsNA()
+	for i, val := range p.data {
+		if na[i] {
+			continue
+		}
+
+		num := big.NewInt(val)
+		den := big.NewInt(1)
+		if scale > p.scale {
+			num.Mul(num, pow10(scale-p.scale))
+		} else {
+			den = pow10(p.scale - scale)
+		}
+
+		data[i], na[i] = decimalOrNA(roundBig(num, den, rounding))
+	}
+
+	return data, na
+}
+
+func (p *decimalPayload) Append(payload Payload) Payload {
+	length := p.length + payload.Len()
+
+	vals, na := decimalsOf(payload, p.scale, p.rounding)
+
+	newVals := make([]int64, length)
+	newNA := make([]bool, length)
+
+	copy(newVals, p.data)
+	copy(newVals[p.length:], vals)
+	p.na.copyTo(newNA)
+	copy(newNA[p.length:], na)
+
+	return DecimalPayload(newVals, newNA, p.Options()...)
+}
+
+func (p *decimalPayload) Adjust(size int) Payload {
+	if size < p.length {
+		data, na := adjustToLesserSizeWithNA(p.data, p.na, size)
+
+		return DecimalPayload(data, na, p.Options()...)
+	}
+
+	if size > p.length {
+		data, na := adjustToBiggerSizeWithNA(p.data, p.na, p.length, size)
+
+		return DecimalPayload(data, na, p.Options()...)
+	}
+
+	return p
+}
+
+func (p *decimalPayload) StrForElem(idx int) string {
+	if p.na.at(idx - 1) {
+		return "NA"
+	}
+
+	return formatDecimal(p.data[idx-1], p.scale)
+}
+
+func (p *decimalPayload) Groups() ([][]int, []any) {
+	groups, values := groupsForData(p.data, p.na)
+
+	for i, val := range values {
+		if val != nil {
+			values[i] = formatDecimal(val.(int64), p.scale)
+		}
+	}
+
+	return groups, values
+}
+
+/* Finder interface */
+
+func (p *decimalPayload) Find(needle any) int {
+	return find(needle, p.data, p.na, p.exactComparator)
+}
+
+func (p *decimalPayload) FindAll(needle any) []int {
+	return findAll(needle, p.data, p.na, p.exactComparator)
+}
+
+/* Ordered interface */
+
+// Values to compare with can be int, int64, float64, string or a decimal vector of length 1. They are compared
+// exactly, without rounding to the scale of the payload.
+
+func (p *decimalPayload) Eq(val any) []bool {
+	return eq(val, p.data, p.na, p.exactComparator)
+}
+
+func (p *decimalPayload) Neq(val any) []bool {
+	return neq(val, p.data, p.na, p.exactComparator)
+}
+
+func (p *decimalPayload) Gt(val any) []bool {
+	return gt(val, p.data, p.na, p.floorComparator)
+}
+
+func (p *decimalPayload) Lt(val any) []bool {
+	if _, exact, ok := p.convertComparator(val); ok && !exact {
+		return lte(val, p.data, p.na, p.floorComparator)
+	}
+
+	return lt(val, p.data, p.na, p.floorComparator)
+}
+
+func (p *decimalPayload) Gte(val any) []bool {
+	if _, exact, ok := p.convertComparator(val); ok && !exact {
+		return gt(val, p.data, p.na, p.floorComparator)
+	}
+
+	return gte(val, p.data, p.na, p.floorComparator)
+}
+
+func (p *decimalPayload) Lte(val any) []bool {
+	return lte(val, p.data, p.na, p.floorComparator)
+}
+
+// convertComparator returns the value scaled to the scale of the payload and rounded down to an integer. exact is
+// false if the value has more digits after the decimal point than the scale allows.
+func (p *decimalPayload) convertComparator(val any) (int64, bool, bool) {
+	var rat *big.Rat
+
+	switch v := val.(type) {
+	case int:
+		rat = new(big.Rat).SetInt64(int64(v))
+	case int64:
+		rat = new(big.Rat).SetInt64(v)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, false, false
+		}
+		rat = new(big.Rat).SetFloat64(v)
+	case string:
+		var ok bool
+		rat, ok = new(big.Rat).SetString(strings.TrimSpace(v))
+		if !ok {
+			return 0, false, false
+		}
+	case Vector:
+		decimal, ok := v.Payload().(*decimalPayload)
+		if !ok || decimal.length != 1 || decimal.na.at(0) {
+			return 0, false, false
+		}
+		rat = new(big.Rat).SetFrac(big.NewInt(decimal.data[0]), pow10(decimal.scale))
+	default:
+		return 0, false, false
+	}
+
+	rat.Mul(rat, new(big.Rat).SetInt(pow10(p.scale)))
+	floor, ok := roundBig(rat.Num(), rat.Denom(), DecimalRoundFloor)
+	if !ok {
+		return 0, false, false
+	}
+
+	return floor, rat.IsInt(), true
+}
+
+func (p *decimalPayload) exactComparator(val any) (int64, bool) {
+	scaled, exact, ok := p.convertComparator(val)
+
+	return scaled, ok && exact
+}
+
+func (p *decimalPayload) floorComparator(val any) (int64, bool) {
+	scaled, _, ok := p.convertComparator(val)
+
+	return scaled, ok
+}
+
+func (p *decimalPayload) IsUnique() []bool {
+	booleans := make([]bool, p.length)
+
+	valuesMap := map[int64]bool{}
+	wasNA := false
+	for i := 0; i < p.length; i++ {
+		is := false
+
+		if p.na.at(i) {
+			if !wasNA {
+				is = true
+				wasNA = true
+			}
+		} else {
+			if _, ok := valuesMap[p.data[i]]; !ok {
+				is = true
+				valuesMap[p.data[i]] = true
+			}
+		}
+
+		booleans[i] = is
+	}
+
+	return booleans
+}
+
+func (p *decimalPayload) Coalesce(payload Payload) Payload {
+	if p.length != payload.Len() {
+		payload = payload.Adjust(p.length)
+	}
+
+	srcData, srcNA := decimalsOf(payload, p.scale, p.rounding)
+
+	dstData := make([]int64, p.length)
+	dstNA := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) && !srcNA[i] {
+			dstData[i] = srcData[i]
+			dstNA[i] = false
+		} else {
+			dstData[i] = p.data[i]
+			dstNA[i] = p.na.at(i)
+		}
+	}
+
+	return DecimalPayload(dstData, dstNA, p.Options()...)
+}
+
+func (p *decimalPayload) Options() []Option {
+	return []Option{
+		ConfOption{keyOptionDecimalScale, p.scale},
+		ConfOption{keyOptionDecimalRounding, p.rounding},
+	}
+}
+
+func (p *decimalPayload) SetOption(name string, val any) bool {
+	switch name {
+	case keyOptionDecimalScale:
+		p.scale = val.(int)
+		if p.scale < 0 {
+			p.scale = 0
+		}
+	case keyOptionDecimalRounding:
+		p.rounding = val.(string)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// decimalsOf converts values of a payload to decimals with the provided scale. Decimal payloads are rescaled,
+// integers and floats are converted through their numeric values and other payloads are parsed from strings.
+func decimalsOf(payload Payload, scale int, rounding string) ([]int64, []bool) {
+	var rats []*big.Rat
+	var na []bool
+
+	switch typed := payload.(type) {
+	case *decimalPayload:
+		return typed.Decimals(scale, rounding)
+	case *integerPayload:
+		rats, na = make([]*big.Rat, typed.length), typed.IsNA()
+		for i, val := range typed.data {
+			rats[i] = new(big.Rat).SetInt64(int64(val))
+		}
+	case *floatPayload:
+		rats, na = make([]*big.Rat, typed.length), typed.IsNA()
+		for i, val := range typed.data {
+			if math.IsNaN(val) || math.IsInf(val, 0) {
+				na[i] = true
+			} else {
+				rats[i] = new(big.Rat).SetFloat64(val)
+			}
+		}
+	default:
+		stringable, ok := payload.(Stringable)
+		if !ok {
+			return make([]int64, payload.Len()), NAPayload(payload.Len()).(NAble).IsNA()
+		}
+
+		var values []string
+		values, na = stringable.Strings()
+		rats = make([]*big.Rat, len(values))
+		for i, val := range values {
+			if na[i] {
+				continue
+			}
+
+			rat, ok := new(big.Rat).SetString(strings.TrimSpace(val))
+			if ok {
+				rats[i] = rat
+			} else {
+				na[i] = true
+			}
+		}
+	}
+
+	multiplier := new(big.Rat).SetInt(pow10(scale))
+
+	data := make([]int64, len(rats))
+	for i, rat := range rats {
+		if na[i] {
+			continue
+		}
+
+		rat.Mul(rat, multiplier)
+		data[i], na[i] = decimalOrNA(roundBig(rat.Num(), rat.Denom(), rounding))
+	}
+
+	return data, na
+}
+
+// roundBig divides num by den (which has to be positive) and rounds the result to an integer by the rounding mode.
+// It returns false if the result does not fit into int64.
+func roundBig(num, den *big.Int, rounding string) (int64, bool) {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if rem.Sign() != 0 {
+		sign := int64(num.Sign())
+		half := new(big.Int).Abs(rem)
+		half.Mul(half, big.NewInt(2))
+		cmp := half.Cmp(den)
+
+		inc := false
+		switch rounding {
+		case DecimalRoundDown:
+		case DecimalRoundUp:
+			inc = true
+		case DecimalRoundFloor:
+			inc = sign < 0
+		case DecimalRoundCeiling:
+			inc = sign > 0
+		case DecimalRoundHalfEven:
+			inc = cmp > 0 || cmp == 0 && quo.Bit(0) == 1
+		default:
+			inc = cmp >= 0
+		}
+
+		if inc {
+			quo.Add(quo, big.NewInt(sign))
+		}
+	}
+
+	if !quo.IsInt64() {
+		return 0, false
+	}
+
+	return quo.Int64(), true
+}
+
+func decimalOrNA(val int64, ok bool) (int64, bool) {
+	if !ok {
+		return 0, true
+	}
+
+	return val, false
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func formatDecimal(val int64, scale int) string {
+	str := new(big.Int).Abs(big.NewInt(val)).String()
+
+	if scale > 0 {
+		if len(str) <= scale {
+			str = strings.Repeat("0", scale-len(str)+1) + str
+		}
+		str = str[:len(str)-scale] + "." + str[len(str)-scale:]
+	}
+
+	if val < 0 {
+		str = "-" + str
+	}
+
+	return str
+}
+
+// DecimalPayload creates a payload with fixed-precision decimal numbers. Data contains unscaled values, so 1234
+// with scale 2 is 12.34.
+//
+// Available options are:
+//   - OptionDecimalScale(scale int) - sets a number of digits after the decimal point (2 by default).
+//   - OptionDecimalRounding(mode string) - sets a rounding mode for results which do not fit into the scale.
+//     Possible modes are DecimalRoundHalfUp (default), DecimalRoundHalfEven, DecimalRoundDown, DecimalRoundUp,
+//     DecimalRoundFloor and DecimalRoundCeiling.
+func DecimalPayload(data []int64, na []bool, options ...Option) Payload {
+	length := len(data)
+	conf := MergeOptions(options)
+
+	vecNA := make([]bool, length)
+	if len(na) > 0 {
+		if len(na) == length {
+			copy(vecNA, na)
+		} else {
+			emp := NAPayload(0)
+			return emp
+		}
+	}
+
+	vecData := make([]int64, length)
+	for i := 0; i < length; i++ {
+		if !vecNA[i] {
+			vecData[i] = data[i]
+		}
+	}
+
+	payload := &decimalPayload{
+		length:   length,
+		data:     vecData,
+		scale:    DefaultDecimalScale,
+		rounding: DecimalRoundHalfUp,
+		DefNAble: newDefNAble(vecNA),
+	}
+
+	conf.SetOptions(payload)
+
+	payload.initArrangeable()
+
+	return payload
+}
+
+// DecimalWithNA creates a vector with DecimalPayload and allows to set NA-values.
+func DecimalWithNA(data []int64, na []bool, options ...Option) Vector {
+	return New(DecimalPayload(data, na, options...), options...)
+}
+
+// Decimal creates a vector with DecimalPayload.
+func Decimal(data []int64, options ...Option) Vector {
+	return DecimalWithNA(data, nil, options...)
+}
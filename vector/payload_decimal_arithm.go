@@ -0,0 +1,132 @@
+package vector
+
+import (
+	"math/big"
+)
+
+// Add adds values of another payload, which are converted to the scale of the decimals first. Values of another
+// decimal payload with a bigger scale are rounded by the rounding mode.
+func (p *decimalPayload) Add(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	addData, addNA := decimalsOf(p2, p.scale, p.rounding)
+
+	data := make([]int64, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || addNA[i] {
+			na[i] = true
+		} else {
+			data[i], na[i] = decimalOrNA(addInt64(p.data[i], addData[i]))
+		}
+	}
+
+	return DecimalPayload(data, na, p.Options()...)
+}
+
+func (p *decimalPayload) Sub(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	subData, subNA := decimalsOf(p2, p.scale, p.rounding)
+
+	data := make([]int64, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || subNA[i] {
+			na[i] = true
+		} else {
+			data[i], na[i] = decimalOrNA(subInt64(p.data[i], subData[i]))
+		}
+	}
+
+	return DecimalPayload(data, na, p.Options()...)
+}
+
+// Mul multiplies the decimals exactly and rounds the result to the scale of the payload.
+func (p *decimalPayload) Mul(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	mulScale := decimalOperandScale(p2, p.scale)
+	mulData, mulNA := decimalsOf(p2, mulScale, p.rounding)
+	den := pow10(mulScale)
+
+	data := make([]int64, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || mulNA[i] {
+			na[i] = true
+		} else {
+			num := new(big.Int).Mul(big.NewInt(p.data[i]), big.NewInt(mulData[i]))
+			data[i], na[i] = decimalOrNA(roundBig(num, den, p.rounding))
+		}
+	}
+
+	return DecimalPayload(data, na, p.Options()...)
+}
+
+// Div divides the decimals exactly and rounds the result to the scale of the payload. Division by zero gives NA.
+func (p *decimalPayload) Div(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	divScale := decimalOperandScale(p2, p.scale)
+	divData, divNA := decimalsOf(p2, divScale, p.rounding)
+	multiplier := pow10(divScale)
+
+	data := make([]int64, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || divNA[i] || divData[i] == 0 {
+			na[i] = true
+		} else {
+			num := new(big.Int).Mul(big.NewInt(p.data[i]), multiplier)
+			den := big.NewInt(divData[i])
+			if den.Sign() < 0 {
+				num.Neg(num)
+				den.Neg(den)
+			}
+			data[i], na[i] = decimalOrNA(roundBig(num, den, p.rounding))
+		}
+	}
+
+	return DecimalPayload(data, na, p.Options()...)
+}
+
+// decimalOperandScale returns the scale used to convert an operand of multiplication or division. Decimal operands
+// keep their own scale, so no precision is lost before the operation.
+func decimalOperandScale(payload Payload, scale int) int {
+	if decimal, ok := payload.(*decimalPayload); ok {
+		return decimal.scale
+	}
+
+	return scale
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, false
+	}
+
+	return sum, true
+}
+
+func subInt64(a, b int64) (int64, bool) {
+	diff := a - b
+	if (b > 0 && diff > a) || (b < 0 && diff < a) {
+		return 0, false
+	}
+
+	return diff, true
+}
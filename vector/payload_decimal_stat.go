@@ -0,0 +1,90 @@
+package vector
+
+import (
+	"math/big"
+)
+
+func (p *decimalPayload) Sum() Payload {
+	var sum int64
+	isNA := false
+
+	for i, val := range p.data {
+		if p.na.at(i) {
+			isNA = true
+			break
+		}
+
+		var ok bool
+		if sum, ok = addInt64(sum, val); !ok {
+			isNA = true
+			break
+		}
+	}
+
+	if isNA {
+		sum = 0
+	}
+
+	return DecimalPayload([]int64{sum}, []bool{isNA}, p.Options()...)
+}
+
+// Mean returns the exact mean rounded to the scale of the payload.
+func (p *decimalPayload) Mean() Payload {
+	if p.length == 0 || p.HasNA() {
+		return DecimalPayload([]int64{0}, []bool{true}, p.Options()...)
+	}
+
+	sum := new(big.Int)
+	for _, val := range p.data {
+		sum.Add(sum, big.NewInt(val))
+	}
+
+	mean, isNA := decimalOrNA(roundBig(sum, big.NewInt(int64(p.length)), p.rounding))
+
+	return DecimalPayload([]int64{mean}, []bool{isNA}, p.Options()...)
+}
+
+func (p *decimalPayload) Max() Payload {
+	if p.length == 0 || p.HasNA() {
+		return DecimalPayload([]int64{0}, []bool{true}, p.Options()...)
+	}
+
+	max, _ := genMax(p.data, p.na)
+
+	return DecimalPayload([]int64{max}, []bool{false}, p.Options()...)
+}
+
+func (p *decimalPayload) Min() Payload {
+	if p.length == 0 || p.HasNA() {
+		return DecimalPayload([]int64{0}, []bool{true}, p.Options()...)
+	}
+
+	min, _ := genMin(p.data, p.na)
+
+	return DecimalPayload([]int64{min}, []bool{false}, p.Options()...)
+}
+
+func (p *decimalPayload) CumSum() Payload {
+	data := make([]int64, p.length)
+	na := make([]bool, p.length)
+
+	var sum int64
+	isNA := false
+	for i, val := range p.data {
+		if !isNA && !p.na.at(i) {
+			var ok bool
+			sum, ok = addInt64(sum, val)
+			isNA = !ok
+		} else {
+			isNA = true
+		}
+
+		if isNA {
+			na[i] = true
+		} else {
+			data[i] = sum
+		}
+	}
+
+	return DecimalPayload(data, na, p.Options()...)
+}
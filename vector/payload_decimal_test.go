@@ -0,0 +1,319 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	testData := []struct {
+		name    string
+		data    []int64
+		na      []bool
+		options []Option
+		outData []int64
+		outNA   []bool
+		scale   int
+		isEmpty bool
+	}{
+		{
+			name:    "normal",
+			data:    []int64{1050, -1, 0},
+			outData: []int64{1050, -1, 0},
+			outNA:   []bool{false, false, false},
+			scale:   2,
+		},
+		{
+			name:    "with na and scale",
+			data:    []int64{1050, 7},
+			na:      []bool{false, true},
+			options: []Option{OptionDecimalScale(4)},
+			outData: []int64{1050, 0},
+			outNA:   []bool{false, true},
+			scale:   4,
+		},
+		{
+			name:    "incorrect sized na",
+			data:    []int64{1, 2},
+			na:      []bool{false},
+			isEmpty: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			vec := DecimalWithNA(data.data, data.na, data.options...)
+
+			if data.isEmpty {
+				if !vec.IsEmpty() {
+					t.Error("Vector is not empty")
+				}
+				return
+			}
+
+			payload, ok := vec.(*vector).Payload().(*decimalPayload)
+			if !ok {
+				t.Error("Payload is not decimalPayload")
+				return
+			}
+
+			if !reflect.DeepEqual(payload.data, data.outData) {
+				t.Error(fmt.Sprintf("Data (%v) are not equal to expected (%v)", payload.data, data.outData))
+			}
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+				t.Error(fmt.Sprintf("NA (%v) are not equal to expected (%v)", payload.IsNA(), data.outNA))
+			}
+			if payload.scale != data.scale {
+				t.Error(fmt.Sprintf("Scale (%v) is not equal to expected (%v)", payload.scale, data.scale))
+			}
+		})
+	}
+}
+
+func TestDecimalPayload_Conversions(t *testing.T) {
+	vec := DecimalWithNA([]int64{1050, -5, 0, 250}, []bool{false, false, true, false}, OptionDecimalScale(2))
+
+	strings, na := vec.Strings()
+	if !reflect.DeepEqual(strings, []string{"10.50", "-0.05", "", "2.50"}) ||
+		!reflect.DeepEqual(na, []bool{false, false, true, false}) {
+		t.Error(fmt.Sprintf("Strings (%v, %v) are not equal to expected", strings, na))
+	}
+
+	floats, _ := vec.Floats()
+	if floats[0] != 10.5 || floats[1] != -0.05 || !math.IsNaN(floats[2]) {
+		t.Error(fmt.Sprintf("Floats (%v) are not equal to expected", floats))
+	}
+
+	integers, _ := vec.Integers()
+	if !reflect.DeepEqual(integers, []int{11, 0, 0, 3}) {
+		t.Error(fmt.Sprintf("Integers (%v) are not equal to expected", integers))
+	}
+
+	integers, _ = vec.AsDecimal(OptionDecimalRounding(DecimalRoundHalfEven)).Integers()
+	if !reflect.DeepEqual(integers, []int{10, 0, 0, 2}) {
+		t.Error(fmt.Sprintf("Half even integers (%v) are not equal to expected", integers))
+	}
+
+	if vec.StrForElem(3) != "NA" || vec.Type() != PayloadTypeDecimal || vec.Pick(1) != "10.50" {
+		t.Error("StrForElem(), Type() or Pick() returned wrong values")
+	}
+}
+
+func TestVector_AsDecimal(t *testing.T) {
+	testData := []struct {
+		name    string
+		vec     Vector
+		options []Option
+		out     Vector
+	}{
+		{
+			name: "string",
+			vec:  StringWithNA([]string{"0.1", " 12.345 ", "bad", ""}, []bool{false, false, false, true}),
+			out:  DecimalWithNA([]int64{10, 1235, 0, 0}, []bool{false, false, true, true}),
+		},
+		{
+			name:    "string with scale",
+			vec:     String([]string{"123456789012345.6789"}),
+			options: []Option{OptionDecimalScale(4)},
+			out:     Decimal([]int64{1234567890123456789}, OptionDecimalScale(4)),
+		},
+		{
+			name:    "float with rounding",
+			vec:     Float([]float64{1.005, -2.5, math.NaN()}),
+			options: []Option{OptionDecimalScale(0), OptionDecimalRounding(DecimalRoundCeiling)},
+			out:     DecimalWithNA([]int64{2, -2, 0}, []bool{false, false, true}, OptionDecimalScale(0)),
+		},
+		{
+			name: "integer",
+			vec:  IntegerWithNA([]int{5, 0}, []bool{false, true}),
+			out:  DecimalWithNA([]int64{500, 0}, []bool{false, true}),
+		},
+		{
+			name:    "decimal rescale",
+			vec:     Decimal([]int64{12345, -12345}, OptionDecimalScale(3)),
+			options: []Option{OptionDecimalScale(1)},
+			out:     Decimal([]int64{123, -123}, OptionDecimalScale(1)),
+		},
+		{
+			name:    "overflow",
+			vec:     String([]string{"99999999999999999999"}),
+			options: []Option{OptionDecimalScale(2)},
+			out:     DecimalWithNA([]int64{0}, []bool{true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			out := data.vec.AsDecimal(data.options...)
+			if !CompareVectorsForTest(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestRoundBig(t *testing.T) {
+	testData := []struct {
+		rounding string
+		out      []int64
+	}{
+		{rounding: DecimalRoundHalfUp, out: []int64{3, -3, 2, -2, 2}},
+		{rounding: DecimalRoundHalfEven, out: []int64{2, -2, 2, -2, 2}},
+		{rounding: DecimalRoundDown, out: []int64{2, -2, 1, -1, 2}},
+		{rounding: DecimalRoundUp, out: []int64{3, -3, 2, -2, 2}},
+		{rounding: DecimalRoundFloor, out: []int64{2, -3, 1, -2, 2}},
+		{rounding: DecimalRoundCeiling, out: []int64{3, -2, 2, -1, 2}},
+	}
+
+	// 2.5, -2.5, 1.6, -1.6, 2.0
+	nums := []int64{25, -25, 16, -16, 20}
+
+	for _, data := range testData {
+		t.Run(data.rounding, func(t *testing.T) {
+			out := make([]int64, len(nums))
+			for i, num := range nums {
+				out[i], _ = roundBig(big.NewInt(num), big.NewInt(10), data.rounding)
+			}
+
+			if !reflect.DeepEqual(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestDecimalPayload_Compare(t *testing.T) {
+	vec := DecimalWithNA([]int64{1050, 1000, 1051, 0}, []bool{false, false, false, true})
+
+	testData := []struct {
+		name string
+		out  []bool
+		exp  []bool
+	}{
+		{name: "eq string", out: vec.Eq("10.5"), exp: []bool{true, false, false, false}},
+		{name: "eq int", out: vec.Eq(10), exp: []bool{false, true, false, false}},
+		{name: "eq inexact", out: vec.Eq(10.505), exp: []bool{false, false, false, false}},
+		{name: "neq", out: vec.Neq("10.50"), exp: []bool{false, true, true, true}},
+		{name: "gt", out: vec.Gt("10.505"), exp: []bool{false, false, true, false}},
+		{name: "gte", out: vec.Gte("10.505"), exp: []bool{false, false, true, false}},
+		{name: "lt", out: vec.Lt("10.505"), exp: []bool{true, true, false, false}},
+		{name: "lte", out: vec.Lte(10.5), exp: []bool{true, true, false, false}},
+		{name: "gte exact", out: vec.Gte(10.51), exp: []bool{false, false, true, false}},
+		{name: "eq of wrong type", out: vec.Eq(true), exp: []bool{false, false, false, false}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+
+	if vec.Find("10") != 2 {
+		t.Error(fmt.Sprintf("Find() (%v) is not equal to expected", vec.Find("10")))
+	}
+}
+
+func TestDecimalPayload_Groups(t *testing.T) {
+	vec := DecimalWithNA([]int64{1050, 0, 100, 1050}, []bool{false, true, false, false})
+
+	groups, values := vec.Groups()
+	if !reflect.DeepEqual(groups, [][]int{{1, 4}, {3}, {2}}) {
+		t.Error(fmt.Sprintf("Groups (%v) are not equal to expected", groups))
+	}
+	if !reflect.DeepEqual(values, []any{"10.50", "1.00", nil}) {
+		t.Error(fmt.Sprintf("Values (%v) are not equal to expected", values))
+	}
+
+	if !reflect.DeepEqual(vec.SortedIndices(), []int{3, 1, 4, 2}) {
+		t.Error(fmt.Sprintf("Sorted indices (%v) are not equal to expected", vec.SortedIndices()))
+	}
+}
+
+func TestDecimalPayload_Arithmetics(t *testing.T) {
+	vec := DecimalWithNA([]int64{1000, 333, 0}, []bool{false, false, true})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "add string",
+			out:  vec.Add(String([]string{"0.1"})),
+			exp:  DecimalWithNA([]int64{1010, 343, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "sub decimal of bigger scale",
+			out:  vec.Sub(Decimal([]int64{5}, OptionDecimalScale(3))),
+			exp:  DecimalWithNA([]int64{999, 332, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "mul by decimal",
+			out:  vec.Mul(Decimal([]int64{15}, OptionDecimalScale(3))),
+			exp:  DecimalWithNA([]int64{15, 5, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "mul by integer",
+			out:  vec.Mul(Integer([]int{3})),
+			exp:  DecimalWithNA([]int64{3000, 999, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "div",
+			out:  vec.Div(Integer([]int{3, 0, 1})),
+			exp:  DecimalWithNA([]int64{333, 0, 0}, []bool{false, true, true}),
+		},
+		{
+			name: "div half even",
+			out: Decimal([]int64{5, 15, -5}, OptionDecimalRounding(DecimalRoundHalfEven)).
+				Div(Integer([]int{2})),
+			exp: Decimal([]int64{2, 8, -2}),
+		},
+		{
+			name: "overflow",
+			out:  Decimal([]int64{math.MaxInt64}).Add(Decimal([]int64{1})),
+			exp:  DecimalWithNA([]int64{0}, []bool{true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestDecimalPayload_Statistics(t *testing.T) {
+	vec := Decimal([]int64{10, 20, 1})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{name: "sum", out: vec.Sum(), exp: Decimal([]int64{31})},
+		{name: "mean", out: vec.Mean(), exp: Decimal([]int64{10})},
+		{name: "max", out: vec.Max(), exp: Decimal([]int64{20})},
+		{name: "min", out: vec.Min(), exp: Decimal([]int64{1})},
+		{name: "cumsum", out: vec.CumSum(), exp: Decimal([]int64{10, 30, 31})},
+		{
+			name: "sum with na",
+			out:  DecimalWithNA([]int64{1, 0}, []bool{false, true}).Sum(),
+			exp:  DecimalWithNA([]int64{0}, []bool{true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+type durationPayload struct {
+	length int
+	data   []time.Duration
+	DefNAble
+	DefArrangeable
+}
+
+func (p *durationPayload) Type() string {
+	return PayloadTypeDuration
+}
+
+func (p *durationPayload) Len() int {
+	return p.length
+}
+
+func (p *durationPayload) Pick(idx int) any {
+	return pickValueWithNA(idx, p.data, p.na, p.length)
+}
+
+func (p *durationPayload) Data() []any {
+	return dataWithNAToInterfaceArray(p.data, p.na)
+}
+
+func (p *durationPayload) ByIndices(indices []int) Payload {
+	data, na := byIndicesWithNA(indices, p.data, p.na, 0)
+
+	return DurationPayload(data, na, p.Options()...)
+}
+
+func (p *durationPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *durationPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
+func (p *durationPayload) SupportsWhicher(whicher any) bool {
+	return supportsWhicherWithNA[time.Duration](whicher)
+}
+
+func (p *durationPayload) Which(whicher any) []bool {
+	return whichWithNA(p.data, p.na, whicher)
+}
+
+func (p *durationPayload) Apply(applier any) Payload {
+	if data, na, ok := applyTypeWithNA[time.Duration, time.Duration](p.data, p.na, applier, 0); ok {
+		return DurationPayload(data, na, p.Options()...)
+	}
+
+	return applyWithNA(p.data, p.na, applier, p.Options())
+}
+
+func (p *durationPayload) ApplyTo(indices []int, applier any) Payload {
+	data, na := applyToWithNA(indices, p.data, p.na, applier, 0)
+
+	if data == nil {
+		return NAPayload(p.length)
+	}
+
+	return DurationPayload(data, na, p.Options()...)
+}
+
+func (p *durationPayload) Traverse(traverser any) {
+	traverseWithNA(p.data, p.na, traverser)
+}
+
+// Integers returns durations in nanoseconds.
+func (p *durationPayload) Integers() ([]int, []bool) {
+	if p.length == 0 {
+		return []int{}, []bool{}
+	}
+
+	data := make([]int, p.length)
+	for i, val := range p.data {
+		data[i] = int(val)
+	}
+
+	return data, p.IsNA()
+}
+
+// Floats returns durations in nanoseconds.
+func (p *durationPayload) Floats() ([]float64, []bool) {
+	if p.length == 0 {
+		return []float64{}, []bool{}
+	}
+
+	data := make([]float64, p.length)
+	for i, val := range p.data {
+		if p.na.at(i) {
+			data[i] = math.NaN()
+		} else {
+			data[i] = float64(val)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *durationPayload) Strings() ([]string, []bool) {
+	if p.length == 0 {
+		return []string{}, []bool{}
+	}
+
+	data := make([]string, p.length)
+	for i, val := range p.data {
+		if !p.na.at(i) {
+			data[i] = val.String()
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *durationPayload) Durations() ([]time.Duration, []bool) {
+	if p.length == 0 {
+		return []time.Duration{}, []bool{}
+	}
+
+	data := make([]time.Duration, p.length)
+	copy(data, p.data)
+
+	return data, p.IsNA()
+}
+
+func (p *durationPayload) Anies() ([]any, []bool) {
+	if p.length == 0 {
+		return []any{}, []bool{}
+	}
+
+	return p.Data(), p.IsNA()
+}
+
+func (p *durationPayload) Append(payload Payload) Payload {
+	length := p.length + payload.Len()
+
+	var vals []time.Duration
+	var na []bool
+
+	if durationable, ok := payload.(Durationable); ok {
+		vals, na = durationable.Durations()
+	} else {
+		vals, na = NAPayload(payload.Len()).(Durationable).Durations()
+	}
+
+	newVals := make([]time.Duration, length)
+	newNA := make([]bool, length)
+
+	copy(newVals, p.data)
+	copy(newVals[p.length:], vals)
+	p.na.copyTo(newNA)
+	copy(newNA[p.length:], na)
+
+	return DurationPayload(newVals, newNA, p.Options()...)
+}
+
+func (p *durationPayload) Adjust(size int) Payload {
+	if size < p.length {
+		data, na := adjustToLesserSizeWithNA(p.data, p.na, size)
+
+		return DurationPayload(data, na, p.Options()...)
+	}
+
+	if size > p.length {
+		data, na := adjustToBiggerSizeWithNA(p.data, p.na, p.length, size)
+
+		return DurationPayload(data, na, p.Options()...)
+	}
+
+	return p
+}
+
+func (p *durationPayload) StrForElem(idx int) string {
+	if p.na.at(idx - 1) {
+		return "NA"
+	}
+
+	return p.data[idx-1].String()
+}
+
+func (p *durationPayload) Groups() ([][]int, []any) {
+	groups, values := groupsForData(p.data, p.na)
+
+	return groups, values
+}
+
+/* Finder interface */
+
+func (p *durationPayload) Find(needle any) int {
+	return find(needle, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) FindAll(needle any) []int {
+	return findAll(needle, p.data, p.na, p.convertComparator)
+}
+
+/* Ordered interface */
+
+func (p *durationPayload) Eq(val any) []bool {
+	return eq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) Neq(val any) []bool {
+	return neq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) Gt(val any) []bool {
+	return gt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) Lt(val any) []bool {
+	return lt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) Gte(val any) []bool {
+	return gte(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) Lte(val any) []bool {
+	return lte(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *durationPayload) convertComparator(val any) (time.Duration, bool) {
+	v, ok := val.(time.Duration)
+
+	return v, ok
+}
+
+func (p *durationPayload) IsUnique() []bool {
+	booleans := make([]bool, p.length)
+
+	valuesMap := map[time.Duration]bool{}
+	wasNA := false
+	for i := 0; i < p.length; i++ {
+		is := false
+
+		if p.na.at(i) {
+			if !wasNA {
+				is = true
+				wasNA = true
+			}
+		} else {
+			if _, ok := valuesMap[p.data[i]]; !ok {
+				is = true
+				valuesMap[p.data[i]] = true
+			}
+		}
+
+		booleans[i] = is
+	}
+
+	return booleans
+}
+
+func (p *durationPayload) Coalesce(payload Payload) Payload {
+	if p.length != payload.Len() {
+		payload = payload.Adjust(p.length)
+	}
+
+	var srcData []time.Duration
+	var srcNA []bool
+
+	if same, ok := payload.(*durationPayload); ok {
+		srcData = same.data
+		srcNA = same.IsNA()
+	} else if durationable, ok := payload.(Durationable); ok {
+		srcData, srcNA = durationable.Durations()
+	} else {
+		return p
+	}
+
+	dstData := make([]time.Duration, p.length)
+	dstNA := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) && !srcNA[i] {
+			dstData[i] = srcData[i]
+			dstNA[i] = false
+		} else {
+			dstData[i] = p.data[i]
+			dstNA[i] = p.na.at(i)
+		}
+	}
+
+	return DurationPayload(dstData, dstNA, p.Options()...)
+}
+
+func (p *durationPayload) Options() []Option {
+	return []Option{}
+}
+
+func (p *durationPayload) SetOption(string, any) bool {
+	return false
+}
+
+// DurationPayload creates a payload with durations. Durations are converted to strings in the format
+// of time.Duration (like "1h30m0s"), integers and floats are treated as nanoseconds.
+func DurationPayload(data []time.Duration, na []bool, options ...Option) Payload {
+	length := len(data)
+	conf := MergeOptions(options)
+
+	vecNA := make([]bool, length)
+	if len(na) > 0 {
+		if len(na) == length {
+			copy(vecNA, na)
+		} else {
+			emp := NAPayload(0)
+			return emp
+		}
+	}
+
+	vecData := make([]time.Duration, length)
+	for i := 0; i < length; i++ {
+		if !vecNA[i] {
+			vecData[i] = data[i]
+		}
+	}
+
+	payload := &durationPayload{
+		length:   length,
+		data:     vecData,
+		DefNAble: newDefNAble(vecNA),
+	}
+
+	conf.SetOptions(payload)
+
+	payload.initArrangeable()
+
+	return payload
+}
+
+// DurationWithNA creates a vector with DurationPayload and allows to set NA-values.
+func DurationWithNA(data []time.Duration, na []bool, options ...Option) Vector {
+	return New(DurationPayload(data, na, options...), options...)
+}
+
+// Duration creates a vector with DurationPayload.
+func Duration(data []time.Duration, options ...Option) Vector {
+	return DurationWithNA(data, nil, options...)
+}
@@ -0,0 +1,134 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+// Add adds durations of another payload. If another payload contains times, the durations are added to the times
+// and a time payload is returned.
+func (p *durationPayload) Add(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	if times, ok := p2.(*timePayload); ok {
+		return times.Add(p)
+	}
+
+	durationable, ok := p2.(Durationable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	addDurations, addNA := durationable.Durations()
+
+	durations := make([]time.Duration, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || addNA[i] {
+			na[i] = true
+		} else {
+			durations[i] = p.data[i] + addDurations[i]
+		}
+	}
+
+	return DurationPayload(durations, na, p.Options()...)
+}
+
+func (p *durationPayload) Sub(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	durationable, ok := p2.(Durationable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	subDurations, subNA := durationable.Durations()
+
+	durations := make([]time.Duration, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || subNA[i] {
+			na[i] = true
+		} else {
+			durations[i] = p.data[i] - subDurations[i]
+		}
+	}
+
+	return DurationPayload(durations, na, p.Options()...)
+}
+
+// Mul multiplies the durations by numbers.
+func (p *durationPayload) Mul(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	if _, ok := p2.(*durationPayload); ok {
+		return NAPayload(p.length)
+	}
+
+	floatable, ok := p2.(Floatable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	mulFloats, mulNA := floatable.Floats()
+
+	durations := make([]time.Duration, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || mulNA[i] || math.IsNaN(mulFloats[i]) {
+			na[i] = true
+		} else {
+			durations[i] = time.Duration(float64(p.data[i]) * mulFloats[i])
+		}
+	}
+
+	return DurationPayload(durations, na, p.Options()...)
+}
+
+// Div divides the durations by numbers. If another payload contains durations, the result is a float payload
+// with ratios of the durations.
+func (p *durationPayload) Div(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	if durations, ok := p2.(*durationPayload); ok {
+		floats := make([]float64, p.length)
+		na := make([]bool, p.length)
+
+		for i := 0; i < p.length; i++ {
+			if p.na.at(i) || durations.na.at(i) || durations.data[i] == 0 {
+				na[i] = true
+			} else {
+				floats[i] = float64(p.data[i]) / float64(durations.data[i])
+			}
+		}
+
+		return FloatPayload(floats, na)
+	}
+
+	floatable, ok := p2.(Floatable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	divFloats, divNA := floatable.Floats()
+
+	durations := make([]time.Duration, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || divNA[i] || math.IsNaN(divFloats[i]) || divFloats[i] == 0 {
+			na[i] = true
+		} else {
+			durations[i] = time.Duration(float64(p.data[i]) / divFloats[i])
+		}
+	}
+
+	return DurationPayload(durations, na, p.Options()...)
+}
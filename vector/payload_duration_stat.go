@@ -0,0 +1,37 @@
+package vector
+
+import (
+	"time"
+)
+
+func (p *durationPayload) Sum() Payload {
+	sum, na := genSum(p.data, p.na)
+
+	return DurationPayload([]time.Duration{sum}, []bool{na}, p.Options()...)
+}
+
+func (p *durationPayload) Mean() Payload {
+	mean, na := genMean(p.data, p.na)
+
+	return DurationPayload([]time.Duration{time.Duration(mean)}, []bool{na}, p.Options()...)
+}
+
+func (p *durationPayload) Max() Payload {
+	if p.length == 0 || p.HasNA() {
+		return DurationPayload([]time.Duration{0}, []bool{true}, p.Options()...)
+	}
+
+	max, _ := genMax(p.data, p.na)
+
+	return DurationPayload([]time.Duration{max}, []bool{false}, p.Options()...)
+}
+
+func (p *durationPayload) Min() Payload {
+	if p.length == 0 || p.HasNA() {
+		return DurationPayload([]time.Duration{0}, []bool{true}, p.Options()...)
+	}
+
+	min, _ := genMin(p.data, p.na)
+
+	return DurationPayload([]time.Duration{min}, []bool{false}, p.Options()...)
+}
@@ -0,0 +1,218 @@
+package vector
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	testData := []struct {
+		name    string
+		data    []time.Duration
+		na      []bool
+		outData []time.Duration
+		outNA   []bool
+		isEmpty bool
+	}{
+		{
+			name:    "normal",
+			data:    []time.Duration{time.Second, time.Hour},
+			outData: []time.Duration{time.Second, time.Hour},
+			outNA:   []bool{false, false},
+		},
+		{
+			name:    "with na",
+			data:    []time.Duration{time.Second, time.Hour},
+			na:      []bool{true, false},
+			outData: []time.Duration{0, time.Hour},
+			outNA:   []bool{true, false},
+		},
+		{
+			name:    "incorrect sized na",
+			data:    []time.Duration{time.Second, time.Hour},
+			na:      []bool{true},
+			isEmpty: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			vec := DurationWithNA(data.data, data.na)
+
+			if data.isEmpty {
+				if !vec.IsEmpty() {
+					t.Error("Vector is not empty")
+				}
+				return
+			}
+
+			payload, ok := vec.(*vector).Payload().(*durationPayload)
+			if !ok {
+				t.Error("Payload is not durationPayload")
+				return
+			}
+
+			if !reflect.DeepEqual(payload.data, data.outData) {
+				t.Error(fmt.Sprintf("Data (%v) are not equal to expected (%v)", payload.data, data.outData))
+			}
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+				t.Error(fmt.Sprintf("NA (%v) are not equal to expected (%v)", payload.IsNA(), data.outNA))
+			}
+		})
+	}
+}
+
+func TestVector_AsDuration(t *testing.T) {
+	testData := []struct {
+		name string
+		vec  Vector
+		out  Vector
+	}{
+		{
+			name: "string",
+			vec:  StringWithNA([]string{"1h30m", "bad", ""}, []bool{false, false, true}),
+			out:  DurationWithNA([]time.Duration{90 * time.Minute, 0, 0}, []bool{false, true, true}),
+		},
+		{
+			name: "integer",
+			vec:  Integer([]int{1000}),
+			out:  Duration([]time.Duration{time.Microsecond}),
+		},
+		{
+			name: "float",
+			vec:  FloatWithNA([]float64{1500.7, 0}, []bool{false, true}),
+			out:  DurationWithNA([]time.Duration{1500, 0}, []bool{false, true}),
+		},
+		{
+			name: "time",
+			vec:  Time([]time.Time{{}}),
+			out:  NA(1),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			out := data.vec.AsDuration()
+			if !CompareVectorsForTest(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestDurationPayload_Conversions(t *testing.T) {
+	vec := DurationWithNA([]time.Duration{90 * time.Minute, 0}, []bool{false, true})
+
+	strings, na := vec.Strings()
+	if !reflect.DeepEqual(strings, []string{"1h30m0s", ""}) || !reflect.DeepEqual(na, []bool{false, true}) {
+		t.Error(fmt.Sprintf("Strings (%v, %v) are not equal to expected", strings, na))
+	}
+
+	integers, _ := vec.Integers()
+	if integers[0] != int(90*time.Minute) {
+		t.Error(fmt.Sprintf("Integers (%v) are not equal to expected", integers))
+	}
+
+	if vec.StrForElem(2) != "NA" || vec.Type() != PayloadTypeDuration {
+		t.Error("StrForElem() or Type() returned wrong values")
+	}
+
+	if !reflect.DeepEqual(vec.Gt(time.Hour), []bool{true, false}) {
+		t.Error(fmt.Sprintf("Gt() (%v) is not equal to expected", vec.Gt(time.Hour)))
+	}
+}
+
+func TestDurationPayload_Arithmetics(t *testing.T) {
+	vec := DurationWithNA([]time.Duration{time.Hour, 30 * time.Minute, 0}, []bool{false, false, true})
+	times := Time([]time.Time{
+		time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 1, 23, 45, 0, 0, time.UTC),
+		time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC),
+	})
+	shifted := TimeWithNA([]time.Time{
+		time.Date(2022, 3, 1, 11, 0, 0, 0, time.UTC),
+		time.Date(2022, 3, 2, 0, 15, 0, 0, time.UTC),
+		{},
+	}, []bool{false, false, true})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "add",
+			out:  vec.Add(Duration([]time.Duration{time.Minute})),
+			exp:  DurationWithNA([]time.Duration{61 * time.Minute, 31 * time.Minute, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "sub",
+			out:  vec.Sub(Duration([]time.Duration{time.Hour})),
+			exp:  DurationWithNA([]time.Duration{0, -30 * time.Minute, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "mul",
+			out:  vec.Mul(Float([]float64{1.5})),
+			exp:  DurationWithNA([]time.Duration{90 * time.Minute, 45 * time.Minute, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "div by number",
+			out:  vec.Div(Integer([]int{2, 0, 1})),
+			exp:  DurationWithNA([]time.Duration{30 * time.Minute, 0, 0}, []bool{false, true, true}),
+		},
+		{
+			name: "div by duration",
+			out:  vec.Div(Duration([]time.Duration{time.Hour})),
+			exp:  FloatWithNA([]float64{1, 0.5, 0}, []bool{false, false, true}),
+		},
+		{
+			name: "add times",
+			out:  vec.Add(times),
+			exp:  shifted,
+		},
+		{
+			name: "add to times",
+			out:  times.Add(vec),
+			exp:  shifted,
+		},
+		{
+			name: "add string",
+			out:  vec.Add(String([]string{"1s"})),
+			exp: DurationWithNA([]time.Duration{time.Hour + time.Second, 30*time.Minute + time.Second, 0},
+				[]bool{false, false, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestDurationPayload_Statistics(t *testing.T) {
+	vec := Duration([]time.Duration{time.Hour, 30 * time.Minute, 2 * time.Hour})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{name: "sum", out: vec.Sum(), exp: Duration([]time.Duration{210 * time.Minute})},
+		{name: "mean", out: vec.Mean(), exp: Duration([]time.Duration{70 * time.Minute})},
+		{name: "max", out: vec.Max(), exp: Duration([]time.Duration{2 * time.Hour})},
+		{name: "min", out: vec.Min(), exp: Duration([]time.Duration{30 * time.Minute})},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
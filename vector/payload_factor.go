@@ -0,0 +1,592 @@
+package vector
+
+import (
+	"golang.org/x/exp/slices"
+	"math"
+	"math/cmplx"
+	"time"
+)
+
+// factorPayload stores categorical values as integer codes of an ordered set of levels. A code is the position of
+// the level starting from 1, zero code is used for NA-values.
+type factorPayload struct {
+	length  int
+	data    []int
+	levels  []string
+	ordered bool
+	DefNAble
+	DefArrangeable
+}
+
+func (p *factorPayload) Type() string {
+	return PayloadTypeFactor
+}
+
+func (p *factorPayload) Len() int {
+	return p.length
+}
+
+func (p *factorPayload) Pick(idx int) any {
+	if idx < 1 || idx > p.length || p.na.at(idx-1) {
+		return nil
+	}
+
+	return p.levels[p.data[idx-1]-1]
+}
+
+func (p *factorPayload) Data() []any {
+	labels, _ := p.Strings()
+
+	return dataWithNAToInterfaceArray(labels, p.na)
+}
+
+func (p *factorPayload) ByIndices(indices []int) Payload {
+	data := byIndicesWithoutNA(indices, p.data, 0)
+
+	return factorPayloadFromCodes(data, p.levels, p.ordered)
+}
+
+func (p *factorPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *factorPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
+func (p *factorPayload) SupportsWhicher(whicher any) bool {
+	return supportsWhicherWithNA[string](whicher)
+}
+
+func (p *factorPayload) Which(whicher any) []bool {
+	labels, _ := p.Strings()
+
+	return whichWithNA(labels, p.na, whicher)
+}
+
+func (p *factorPayload) Apply(applier any) Payload {
+	labels, _ := p.Strings()
+
+	return applyWithNA(labels, p.na, applier, nil)
+}
+
+func (p *factorPayload) Traverse(traverser any) {
+	labels, _ := p.Strings()
+
+	traverseWithNA(labels, p.na, traverser)
+}
+
+func (p *factorPayload) ApplyTo(indices []int, applier any) Payload {
+	labels, _ := p.Strings()
+
+	data, na := applyToWithNA(indices, labels, p.na, applier, "")
+	if data == nil {
+		return NAPayload(p.length)
+	}
+
+	levels, codes := extendLevels(p.levels, data, na)
+
+	return factorPayloadFromCodes(codes, levels, p.ordered)
+}
+
+func (p *factorPayload) Levels() []string {
+	levels := make([]string, len(p.levels))
+	copy(levels, p.levels)
+
+	return levels
+}
+
+func (p *factorPayload) IsOrdered() bool {
+	return p.ordered
+}
+
+// Relevel moves the levels to the beginning of the level set in the given order. Other levels keep their
+// relative order. Unknown levels are ignored.
+func (p *factorPayload) Relevel(levels ...string) Payload {
+	newLevels := make([]string, 0, len(p.levels))
+	for _, level := range levels {
+		if slices.Contains(p.levels, level) && !slices.Contains(newLevels, level) {
+			newLevels = append(newLevels, level)
+		}
+	}
+
+	for _, level := range p.levels {
+		if !slices.Contains(newLevels, level) {
+			newLevels = append(newLevels, level)
+		}
+	}
+
+	return p.recode(newLevels)
+}
+
+// DropLevels removes levels which are not used by any element.
+func (p *factorPayload) DropLevels() Payload {
+	used := make([]bool, len(p.levels)+1)
+	for _, code := range p.data {
+		used[code] = true
+	}
+
+	levels := make([]string, 0, len(p.levels))
+	for i, level := range p.levels {
+		if used[i+1] {
+			levels = append(levels, level)
+		}
+	}
+
+	return p.recode(levels)
+}
+
+// recode returns a payload with the same values but with the new level set. Values which are not in the new level
+// set become NA.
+func (p *factorPayload) recode(levels []string) Payload {
+	mapping := make([]int, len(p.levels)+1)
+	for i, level := range p.levels {
+		mapping[i+1] = slices.Index(levels, level) + 1
+	}
+
+	data := make([]int, p.length)
+	for i, code := range p.data {
+		data[i] = mapping[code]
+	}
+
+	return factorPayloadFromCodes(data, levels, p.ordered)
+}
+
+func (p *factorPayload) Anies() ([]any, []bool) {
+	if p.length == 0 {
+		return []any{}, []bool{}
+	}
+
+	return p.Data(), p.IsNA()
+}
+
+// Integers returns codes of the levels.
+func (p *factorPayload) Integers() ([]int, []bool) {
+	if p.length == 0 {
+		return []int{}, []bool{}
+	}
+
+	data := make([]int, p.length)
+	copy(data, p.data)
+
+	return data, p.IsNA()
+}
+
+// Floats returns codes of the levels.
+func (p *factorPayload) Floats() ([]float64, []bool) {
+	if p.length == 0 {
+		return []float64{}, []bool{}
+	}
+
+	data := make([]float64, p.length)
+	for i, code := range p.data {
+		if p.na.at(i) {
+			data[i] = math.NaN()
+		} else {
+			data[i] = float64(code)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+// Complexes returns codes of the levels.
+func (p *factorPayload) Complexes() ([]complex128, []bool) {
+	if p.length == 0 {
+		return []complex128{}, []bool{}
+	}
+
+	data := make([]complex128, p.length)
+	for i, code := range p.data {
+		if p.na.at(i) {
+			data[i] = cmplx.NaN()
+		} else {
+			data[i] = complex(float64(code), 0)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+// Booleans converts labels of the levels in the same way as the string payload does.
+func (p *factorPayload) Booleans() ([]bool, []bool) {
+	return StringPayload(p.Strings()).(Boolable).Booleans()
+}
+
+// Times converts labels of the levels in the same way as the string payload does.
+func (p *factorPayload) Times() ([]time.Time, []bool) {
+	return StringPayload(p.Strings()).(Timeable).Times()
+}
+
+// Dates converts labels of the levels in the same way as the string payload does.
+func (p *factorPayload) Dates() ([]time.Time, []bool) {
+	return StringPayload(p.Strings()).(Dateable).Dates()
+}
+
+func (p *factorPayload) Strings() ([]string, []bool) {
+	if p.length == 0 {
+		return []string{}, []bool{}
+	}
+
+	data := make([]string, p.length)
+	for i, code := range p.data {
+		if !p.na.at(i) {
+			data[i] = p.levels[code-1]
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+// Append appends another payload. If the payload is a factor, its codes are remapped to the current level set.
+// Otherwise, its values are converted to strings. In both cases new values are added to the end of the level set.
+func (p *factorPayload) Append(payload Payload) Payload {
+	var labels []string
+	var na []bool
+
+	if stringable, ok := payload.(Stringable); ok {
+		labels, na = stringable.Strings()
+	} else {
+		labels, na = NAPayload(payload.Len()).(Stringable).Strings()
+	}
+
+	levels := p.levels
+	if factor, ok := payload.(*factorPayload); ok {
+		levels, _ = extendLevels(levels, factor.levels, make([]bool, len(factor.levels)))
+	}
+
+	levels, codes := extendLevels(levels, labels, na)
+
+	data := make([]int, p.length+len(codes))
+	copy(data, p.data)
+	copy(data[p.length:], codes)
+
+	return factorPayloadFromCodes(data, levels, p.ordered)
+}
+
+// Groups returns groups in the order of the first appearance. The codes are used as group keys directly.
+func (p *factorPayload) Groups() ([][]int, []any) {
+	positions := make([]int, len(p.levels)+1)
+	groups := [][]int{}
+	values := []any{}
+
+	for i, code := range p.data {
+		if positions[code] == 0 {
+			groups = append(groups, []int{})
+			if code == 0 {
+				values = append(values, nil)
+			} else {
+				values = append(values, p.levels[code-1])
+			}
+			positions[code] = len(groups)
+		}
+
+		groups[positions[code]-1] = append(groups[positions[code]-1], i+1)
+	}
+
+	if naPos := positions[0]; naPos > 0 && naPos < len(groups) {
+		naGroup := groups[naPos-1]
+		groups = append(groups[:naPos-1], groups[naPos:]...)
+		groups = append(groups, naGroup)
+
+		values = append(values[:naPos-1], values[naPos:]...)
+		values = append(values, nil)
+	}
+
+	return groups, values
+}
+
+func (p *factorPayload) StrForElem(idx int) string {
+	if p.na.at(idx - 1) {
+		return "NA"
+	}
+
+	return p.levels[p.data[idx-1]-1]
+}
+
+func (p *factorPayload) Adjust(size int) Payload {
+	if size < p.length {
+		return factorPayloadFromCodes(adjustToLesserSizeWithoutNA(p.data, size), p.levels, p.ordered)
+	}
+
+	if size > p.length {
+		return factorPayloadFromCodes(adjustToBiggerSizeWithoutNA(p.data, p.length, size), p.levels, p.ordered)
+	}
+
+	return p
+}
+
+/* Finder interface */
+
+func (p *factorPayload) Find(needle any) int {
+	return find(needle, p.data, p.na, p.convertComparator)
+}
+
+func (p *factorPayload) FindAll(needle any) []int {
+	return findAll(needle, p.data, p.na, p.convertComparator)
+}
+
+func (p *factorPayload) Eq(val any) []bool {
+	return eq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *factorPayload) Neq(val any) []bool {
+	return neq(val, p.data, p.na, p.convertComparator)
+}
+
+// Gt compares elements by the level order. It is supported only by ordered factors.
+func (p *factorPayload) Gt(val any) []bool {
+	return gt(val, p.data, p.na, p.orderedComparator)
+}
+
+// Lt compares elements by the level order. It is supported only by ordered factors.
+func (p *factorPayload) Lt(val any) []bool {
+	return lt(val, p.data, p.na, p.orderedComparator)
+}
+
+// Gte compares elements by the level order. It is supported only by ordered factors.
+func (p *factorPayload) Gte(val any) []bool {
+	return gte(val, p.data, p.na, p.orderedComparator)
+}
+
+// Lte compares elements by the level order. It is supported only by ordered factors.
+func (p *factorPayload) Lte(val any) []bool {
+	return lte(val, p.data, p.na, p.orderedComparator)
+}
+
+func (p *factorPayload) convertComparator(val any) (int, bool) {
+	label, ok := val.(string)
+	if !ok {
+		return 0, false
+	}
+
+	code := slices.Index(p.levels, label) + 1
+
+	return code, code > 0
+}
+
+func (p *factorPayload) orderedComparator(val any) (int, bool) {
+	if !p.ordered {
+		return 0, false
+	}
+
+	return p.convertComparator(val)
+}
+
+func (p *factorPayload) IsUnique() []bool {
+	booleans := make([]bool, p.length)
+
+	seen := make([]bool, len(p.levels)+1)
+	for i, code := range p.data {
+		if !seen[code] {
+			booleans[i] = true
+			seen[code] = true
+		}
+	}
+
+	return booleans
+}
+
+func (p *factorPayload) Coalesce(payload Payload) Payload {
+	if p.length != payload.Len() {
+		payload = payload.Adjust(p.length)
+	}
+
+	stringable, ok := payload.(Stringable)
+	if !ok {
+		return p
+	}
+
+	srcData, srcNA := stringable.Strings()
+	for i := range srcNA {
+		srcNA[i] = srcNA[i] || !p.na.at(i)
+	}
+
+	levels, srcCodes := extendLevels(p.levels, srcData, srcNA)
+
+	data := make([]int, p.length)
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) {
+			data[i] = srcCodes[i]
+		} else {
+			data[i] = p.data[i]
+		}
+	}
+
+	return factorPayloadFromCodes(data, levels, p.ordered)
+}
+
+func (p *factorPayload) Options() []Option {
+	return []Option{
+		ConfOption{keyOptionFactorLevels, p.Levels()},
+		ConfOption{keyOptionFactorOrdered, p.ordered},
+	}
+}
+
+func (p *factorPayload) SetOption(name string, val any) bool {
+	switch name {
+	case keyOptionFactorLevels:
+		levels := uniqueLevels(val.([]string))
+		if !slices.Equal(levels, p.levels) {
+			recoded := p.recode(levels).(*factorPayload)
+			p.data = recoded.data
+			p.DefNAble = recoded.DefNAble
+			p.levels = recoded.levels
+			p.initArrangeable()
+		}
+	case keyOptionFactorOrdered:
+		p.ordered = val.(bool)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// extendLevels returns the level set extended with new values (in the order of the first appearance) and codes
+// of the values.
+func extendLevels(levels []string, data []string, na []bool) ([]string, []int) {
+	newLevels := make([]string, len(levels), len(levels)+1)
+	copy(newLevels, levels)
+
+	index := make(map[string]int, len(levels))
+	for i, level := range levels {
+		index[level] = i + 1
+	}
+
+	codes := make([]int, len(data))
+	for i, label := range data {
+		if na[i] {
+			continue
+		}
+
+		code, ok := index[label]
+		if !ok {
+			newLevels = append(newLevels, label)
+			code = len(newLevels)
+			index[label] = code
+		}
+
+		codes[i] = code
+	}
+
+	return newLevels, codes
+}
+
+// uniqueLevels returns the level set without duplicates.
+func uniqueLevels(levels []string) []string {
+	unique, _ := extendLevels([]string{}, levels, make([]bool, len(levels)))
+
+	return unique
+}
+
+// sortedLevels returns unique non-NA values in ascending order.
+func sortedLevels(data []string, na []bool) []string {
+	levels, _ := extendLevels([]string{}, data, na)
+	slices.Sort(levels)
+
+	return levels
+}
+
+// factorPayloadFromCodes creates a factor payload from codes. Codes which are out of the level set range
+// become NA.
+func factorPayloadFromCodes(codes []int, levels []string, ordered bool) Payload {
+	length := len(codes)
+
+	data := make([]int, length)
+	na := make([]bool, length)
+	for i, code := range codes {
+		if code < 1 || code > len(levels) {
+			na[i] = true
+		} else {
+			data[i] = code
+		}
+	}
+
+	vecLevels := make([]string, len(levels))
+	copy(vecLevels, levels)
+
+	payload := &factorPayload{
+		length:   length,
+		data:     data,
+		levels:   vecLevels,
+		ordered:  ordered,
+		DefNAble: newDefNAble(na),
+	}
+
+	payload.initArrangeable()
+
+	return payload
+}
+
+// FactorPayload creates a payload with categorical data. Values are stored as codes of the level set which is
+// sorted unique values of the data by default. Elements are sorted by the level order.
+//
+// Available options are:
+//   - OptionLevels(levels ...string) - sets the level set explicitly. Values which are not in the level set
+//     become NA.
+//   - OptionFactorOrdered(ordered bool) - makes the factor ordered, so its elements can be compared with Gt(),
+//     Lt() etc. using the level order.
+func FactorPayload(data []string, na []bool, options ...Option) Payload {
+	length := len(data)
+	conf := MergeOptions(options)
+
+	vecNA := make([]bool, length)
+	if len(na) > 0 {
+		if len(na) == length {
+			copy(vecNA, na)
+		} else {
+			emp := NAPayload(0)
+			return emp
+		}
+	}
+
+	var levels []string
+	if conf.HasOption(keyOptionFactorLevels) {
+		levels = uniqueLevels(conf.Value(keyOptionFactorLevels).([]string))
+	} else {
+		levels = sortedLevels(data, vecNA)
+	}
+
+	index := make(map[string]int, len(levels))
+	for i, level := range levels {
+		index[level] = i + 1
+	}
+
+	codes := make([]int, length)
+	for i := 0; i < length; i++ {
+		if !vecNA[i] {
+			codes[i] = index[data[i]]
+		}
+	}
+
+	payload := factorPayloadFromCodes(codes, levels, false)
+	conf.SetOptions(payload)
+
+	return payload
+}
+
+// FactorWithNA creates a vector with FactorPayload and allows to set NA-values.
+func FactorWithNA(data []string, na []bool, options ...Option) Vector {
+	return New(FactorPayload(data, na, options...), options...)
+}
+
+// Factor creates a vector with FactorPayload.
+func Factor(data []string, options ...Option) Vector {
+	return FactorWithNA(data, nil, options...)
+}
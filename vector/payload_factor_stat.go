@@ -0,0 +1,23 @@
+package vector
+
+// Max returns the greatest element by the level order. It is NA for unordered factors.
+func (p *factorPayload) Max() Payload {
+	if !p.ordered || p.length == 0 || p.HasNA() {
+		return factorPayloadFromCodes([]int{0}, p.levels, p.ordered)
+	}
+
+	max, _ := genMax(p.data, p.na)
+
+	return factorPayloadFromCodes([]int{max}, p.levels, p.ordered)
+}
+
+// Min returns the least element by the level order. It is NA for unordered factors.
+func (p *factorPayload) Min() Payload {
+	if !p.ordered || p.length == 0 || p.HasNA() {
+		return factorPayloadFromCodes([]int{0}, p.levels, p.ordered)
+	}
+
+	min, _ := genMin(p.data, p.na)
+
+	return factorPayloadFromCodes([]int{min}, p.levels, p.ordered)
+}
@@ -0,0 +1,371 @@
+package vector
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFactor(t *testing.T) {
+	testData := []struct {
+		name    string
+		data    []string
+		na      []bool
+		options []Option
+		codes   []int
+		outNA   []bool
+		levels  []string
+		ordered bool
+		isEmpty bool
+	}{
+		{
+			name:   "default levels",
+			data:   []string{"low", "high", "mid", "low"},
+			codes:  []int{2, 1, 3, 2},
+			outNA:  []bool{false, false, false, false},
+			levels: []string{"high", "low", "mid"},
+		},
+		{
+			name:   "with na",
+			data:   []string{"low", "high", "mid", "low"},
+			na:     []bool{false, true, false, false},
+			codes:  []int{1, 0, 2, 1},
+			outNA:  []bool{false, true, false, false},
+			levels: []string{"low", "mid"},
+		},
+		{
+			name:    "explicit levels",
+			data:    []string{"low", "high", "mid", "unknown"},
+			options: []Option{OptionLevels("low", "mid", "high", "mid"), OptionFactorOrdered(true)},
+			codes:   []int{1, 3, 2, 0},
+			outNA:   []bool{false, false, false, true},
+			levels:  []string{"low", "mid", "high"},
+			ordered: true,
+		},
+		{
+			name:    "incorrect sized na",
+			data:    []string{"low", "high"},
+			na:      []bool{false},
+			isEmpty: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			vec := FactorWithNA(data.data, data.na, data.options...)
+
+			if data.isEmpty {
+				if !vec.IsEmpty() {
+					t.Error("Vector is not empty")
+				}
+				return
+			}
+
+			payload, ok := vec.(*vector).Payload().(*factorPayload)
+			if !ok {
+				t.Error("Payload is not factorPayload")
+				return
+			}
+
+			if !reflect.DeepEqual(payload.data, data.codes) {
+				t.Error(fmt.Sprintf("Codes (%v) are not equal to expected (%v)", payload.data, data.codes))
+			}
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+				t.Error(fmt.Sprintf("NA (%v) are not equal to expected (%v)", payload.IsNA(), data.outNA))
+			}
+			if !reflect.DeepEqual(vec.Levels(), data.levels) {
+				t.Error(fmt.Sprintf("Levels (%v) are not equal to expected (%v)", vec.Levels(), data.levels))
+			}
+			if vec.IsOrdered() != data.ordered {
+				t.Error(fmt.Sprintf("Ordered (%v) is not equal to expected (%v)", vec.IsOrdered(), data.ordered))
+			}
+			if vec.Type() != PayloadTypeFactor {
+				t.Error(fmt.Sprintf("Type (%v) is not equal to expected (%v)", vec.Type(), PayloadTypeFactor))
+			}
+		})
+	}
+}
+
+func TestFactorPayload_Conversions(t *testing.T) {
+	payload := FactorPayload([]string{"b", "a", "", "b"}, []bool{false, false, true, false})
+
+	strings, na := payload.(Stringable).Strings()
+	if !reflect.DeepEqual(strings, []string{"b", "a", "", "b"}) ||
+		!reflect.DeepEqual(na, []bool{false, false, true, false}) {
+		t.Error(fmt.Sprintf("Strings (%v, %v) are not equal to expected", strings, na))
+	}
+
+	integers, na := payload.(Intable).Integers()
+	if !reflect.DeepEqual(integers, []int{2, 1, 0, 2}) ||
+		!reflect.DeepEqual(na, []bool{false, false, true, false}) {
+		t.Error(fmt.Sprintf("Integers (%v, %v) are not equal to expected", integers, na))
+	}
+
+	floats, _ := payload.(Floatable).Floats()
+	if floats[0] != 2 || floats[1] != 1 || floats[3] != 2 {
+		t.Error(fmt.Sprintf("Floats (%v) are not equal to expected", floats))
+	}
+
+	anies, _ := payload.(Anyable).Anies()
+	if !reflect.DeepEqual(anies, []any{"b", "a", nil, "b"}) {
+		t.Error(fmt.Sprintf("Anies (%v) are not equal to expected", anies))
+	}
+
+	booleans, na := FactorPayload([]string{"true", "false", "maybe"}, nil).(Boolable).Booleans()
+	if !reflect.DeepEqual(booleans, []bool{true, false, false}) ||
+		!reflect.DeepEqual(na, []bool{false, false, true}) {
+		t.Error(fmt.Sprintf("Booleans (%v, %v) are not equal to expected", booleans, na))
+	}
+
+	if payload.StrForElem(1) != "b" || payload.StrForElem(3) != "NA" {
+		t.Error("StrForElem() returned wrong values")
+	}
+
+	if payload.Pick(2) != "a" || payload.Pick(3) != nil {
+		t.Error("Pick() returned wrong values")
+	}
+}
+
+func TestFactorPayload_ByIndices(t *testing.T) {
+	vec := Factor([]string{"a", "b", "c"})
+
+	testData := []struct {
+		name    string
+		indices []int
+		out     Vector
+	}{
+		{
+			name:    "normal",
+			indices: []int{3, 1, 1},
+			out:     FactorWithNA([]string{"c", "a", "a"}, nil, OptionLevels("a", "b", "c")),
+		},
+		{
+			name:    "with zero index",
+			indices: []int{2, 0},
+			out: FactorWithNA([]string{"b", ""}, []bool{false, true},
+				OptionLevels("a", "b", "c")),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			out := vec.ByIndices(data.indices)
+			if !CompareVectorsForTest(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestFactorPayload_Append(t *testing.T) {
+	vec := Factor([]string{"b", "a"})
+
+	testData := []struct {
+		name string
+		vec  Vector
+		out  Vector
+	}{
+		{
+			name: "factor",
+			vec:  Factor([]string{"c", "a"}),
+			out:  FactorWithNA([]string{"b", "a", "c", "a"}, nil, OptionLevels("a", "b", "c")),
+		},
+		{
+			name: "string",
+			vec:  StringWithNA([]string{"d", ""}, []bool{false, true}),
+			out: FactorWithNA([]string{"b", "a", "d", ""}, []bool{false, false, false, true},
+				OptionLevels("a", "b", "d")),
+		},
+		{
+			name: "na",
+			vec:  NA(1),
+			out: FactorWithNA([]string{"b", "a", ""}, []bool{false, false, true},
+				OptionLevels("a", "b")),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			out := vec.Append(data.vec)
+			if !CompareVectorsForTest(out, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, data.out))
+			}
+		})
+	}
+}
+
+func TestFactorPayload_Groups(t *testing.T) {
+	vec := FactorWithNA([]string{"b", "", "a", "b", "a"}, []bool{false, true, false, false, false})
+
+	groups, values := vec.Groups()
+	if !reflect.DeepEqual(groups, [][]int{{1, 4}, {3, 5}, {2}}) {
+		t.Error(fmt.Sprintf("Groups (%v) are not equal to expected", groups))
+	}
+	if !reflect.DeepEqual(values, []any{"b", "a", nil}) {
+		t.Error(fmt.Sprintf("Values (%v) are not equal to expected", values))
+	}
+}
+
+func TestFactorPayload_SortedIndices(t *testing.T) {
+	vec := FactorWithNA([]string{"mid", "", "high", "low", "mid"}, []bool{false, true, false, false, false},
+		OptionLevels("low", "mid", "high"))
+
+	indices := vec.SortedIndices()
+	if !reflect.DeepEqual(indices, []int{4, 1, 5, 3, 2}) {
+		t.Error(fmt.Sprintf("Sorted indices (%v) are not equal to expected", indices))
+	}
+
+	indices = vec.Relevel("high").SortedIndices()
+	if !reflect.DeepEqual(indices, []int{3, 4, 1, 5, 2}) {
+		t.Error(fmt.Sprintf("Sorted indices after relevel (%v) are not equal to expected", indices))
+	}
+}
+
+func TestFactorPayload_Compare(t *testing.T) {
+	levels := OptionLevels("low", "mid", "high")
+	unordered := Factor([]string{"low", "high", "mid"}, levels)
+	ordered := Factor([]string{"low", "high", "mid"}, levels, OptionFactorOrdered(true))
+
+	testData := []struct {
+		name string
+		out  []bool
+		exp  []bool
+	}{
+		{name: "eq", out: unordered.Eq("mid"), exp: []bool{false, false, true}},
+		{name: "eq unknown", out: unordered.Eq("none"), exp: []bool{false, false, false}},
+		{name: "neq", out: unordered.Neq("mid"), exp: []bool{true, true, false}},
+		{name: "gt unordered", out: unordered.Gt("low"), exp: []bool{false, false, false}},
+		{name: "gt ordered", out: ordered.Gt("low"), exp: []bool{false, true, true}},
+		{name: "lt ordered", out: ordered.Lt("high"), exp: []bool{true, false, true}},
+		{name: "gte ordered", out: ordered.Gte("mid"), exp: []bool{false, true, true}},
+		{name: "lte ordered", out: ordered.Lte("mid"), exp: []bool{true, false, true}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+
+	if ordered.Find("mid") != 3 || !reflect.DeepEqual(ordered.FindAll("low"), []int{1}) {
+		t.Error("Find() or FindAll() returned wrong values")
+	}
+}
+
+func TestFactorPayload_Levels(t *testing.T) {
+	vec := Factor([]string{"b", "c", "b"}, OptionLevels("a", "b", "c", "d"))
+
+	testData := []struct {
+		name string
+		vec  Vector
+		out  Vector
+	}{
+		{
+			name: "relevel",
+			vec:  vec.Relevel("c", "unknown", "d"),
+			out:  Factor([]string{"b", "c", "b"}, OptionLevels("c", "d", "a", "b")),
+		},
+		{
+			name: "drop levels",
+			vec:  vec.DropLevels(),
+			out:  Factor([]string{"b", "c", "b"}, OptionLevels("b", "c")),
+		},
+		{
+			name: "as factor with levels",
+			vec:  vec.AsFactor(OptionLevels("c", "b")),
+			out:  Factor([]string{"b", "c", "b"}, OptionLevels("c", "b")),
+		},
+		{
+			name: "as ordered factor",
+			vec:  vec.AsOrderedFactor(),
+			out: Factor([]string{"b", "c", "b"}, OptionLevels("a", "b", "c", "d"),
+				OptionFactorOrdered(true)),
+		},
+		{
+			name: "as factor with ordered option",
+			vec:  String([]string{"b", "c"}).AsFactor(OptionLevels("c", "b"), OptionFactorOrdered(true)),
+			out:  Factor([]string{"b", "c"}, OptionLevels("c", "b"), OptionFactorOrdered(true)),
+		},
+		{
+			name: "integer as factor",
+			vec:  IntegerWithNA([]int{10, 2, 0, 2}, []bool{false, false, true, false}).AsFactor(),
+			out: FactorWithNA([]string{"10", "2", "", "2"}, []bool{false, false, true, false},
+				OptionLevels("2", "10")),
+		},
+		{
+			name: "relevel of string",
+			vec:  String([]string{"a"}).Relevel("a"),
+			out:  String([]string{"a"}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.vec, data.out) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.vec, data.out))
+			}
+		})
+	}
+
+	if String([]string{"a"}).Levels() != nil {
+		t.Error("Levels() of a string vector is not nil")
+	}
+}
+
+func TestFactorPayload_IsUnique(t *testing.T) {
+	vec := FactorWithNA([]string{"a", "b", "", "a", ""}, []bool{false, false, true, false, true})
+
+	unique := vec.Unique()
+	expected := FactorWithNA([]string{"a", "b", ""}, []bool{false, false, true})
+	if !CompareVectorsForTest(unique, expected) {
+		t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", unique, expected))
+	}
+}
+
+func TestFactorPayload_Coalesce(t *testing.T) {
+	vec := FactorWithNA([]string{"a", "", ""}, []bool{false, true, true})
+
+	out := vec.Coalesce(StringWithNA([]string{"b", "c", ""}, []bool{false, false, true}))
+	expected := FactorWithNA([]string{"a", "c", ""}, []bool{false, false, true},
+		OptionLevels("a", "c"))
+	if !CompareVectorsForTest(out, expected) {
+		t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", out, expected))
+	}
+}
+
+func TestFactorPayload_MaxMin(t *testing.T) {
+	levels := OptionLevels("low", "mid", "high")
+
+	testData := []struct {
+		name string
+		vec  Vector
+		max  Vector
+		min  Vector
+	}{
+		{
+			name: "ordered",
+			vec:  Factor([]string{"mid", "low", "high", "mid"}, levels, OptionFactorOrdered(true)),
+			max:  Factor([]string{"high"}, levels, OptionFactorOrdered(true)),
+			min:  Factor([]string{"low"}, levels, OptionFactorOrdered(true)),
+		},
+		{
+			name: "unordered",
+			vec:  Factor([]string{"mid", "low"}, levels),
+			max:  FactorWithNA([]string{""}, []bool{true}, levels),
+			min:  FactorWithNA([]string{""}, []bool{true}, levels),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if max := data.vec.Max(); !CompareVectorsForTest(max, data.max) {
+				t.Error(fmt.Sprintf("Max (%v) is not equal to expected (%v)", max, data.max))
+			}
+			if min := data.vec.Min(); !CompareVectorsForTest(min, data.min) {
+				t.Error(fmt.Sprintf("Min (%v) is not equal to expected (%v)", min, data.min))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"math/cmplx"
 	"strconv"
+	"time"
 )
 
 type FloatPrinter struct {
@@ -40,6 +41,29 @@ func (p *floatPayload) ByIndices(indices []int) Payload {
 	return FloatPayload(data, na, p.Options()...)
 }
 
+func (p *floatPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *floatPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
 func (p *floatPayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[float64](whicher)
 }
@@ -83,10 +107,10 @@ func (p *floatPayload) Integers() ([]int, []bool) {
 
 	data := make([]int, p.length)
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = 0
 		} else if math.IsNaN(p.data[i]) || math.IsInf(p.data[i], 1) || math.IsInf(p.data[i], -1) {
 			data[i] = 0
@@ -108,7 +132,7 @@ func (p *floatPayload) Floats() ([]float64, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -120,7 +144,7 @@ func (p *floatPayload) Complexes() ([]complex128, []bool) {
 
 	data := make([]complex128, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = cmplx.NaN()
 		} else {
 			data[i] = complex(p.data[i], 0)
@@ -128,7 +152,7 @@ func (p *floatPayload) Complexes() ([]complex128, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -141,7 +165,7 @@ func (p *floatPayload) Booleans() ([]bool, []bool) {
 	data := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = false
 		} else {
 			data[i] = p.data[i] != 0
@@ -149,7 +173,7 @@ func (p *floatPayload) Booleans() ([]bool, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -166,7 +190,26 @@ func (p *floatPayload) Strings() ([]string, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
+
+	return data, na
+}
+
+// Durations treats floats as nanoseconds. Fractional parts of nanoseconds are dropped.
+func (p *floatPayload) Durations() ([]time.Duration, []bool) {
+	if p.length == 0 {
+		return []time.Duration{}, []bool{}
+	}
+
+	data := make([]time.Duration, p.length)
+	na := make([]bool, p.length)
+	for i, val := range p.data {
+		if p.na.at(i) || math.IsNaN(val) || math.IsInf(val, 0) {
+			na[i] = true
+		} else {
+			data[i] = time.Duration(val)
+		}
+	}
 
 	return data, na
 }
@@ -178,7 +221,7 @@ func (p *floatPayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -186,7 +229,7 @@ func (p *floatPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -194,7 +237,7 @@ func (p *floatPayload) Anies() ([]any, []bool) {
 func (p *floatPayload) StrForElem(idx int) string {
 	i := idx - 1
 
-	if p.na[i] {
+	if p.na.at(i) {
 		return "NA"
 	}
 
@@ -230,7 +273,7 @@ func (p *floatPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return FloatPayload(newVals, newNA, p.Options()...)
@@ -350,7 +393,7 @@ func (p *floatPayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -393,7 +436,7 @@ func (p *floatPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*floatPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if floatable, ok := payload.(Floatable); ok {
 		srcData, srcNA = floatable.Floats()
 	} else {
@@ -404,12 +447,12 @@ func (p *floatPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -465,26 +508,15 @@ func FloatPayload(data []float64, na []bool, options ...Option) Payload {
 	}
 
 	payload := &floatPayload{
-		length:  length,
-		data:    vecData,
-		printer: printer,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		printer:  printer,
+		DefNAble: newDefNAble(vecNA),
 	}
 
 	conf.SetOptions(payload)
 
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess: func(i, j int) bool {
-			return payload.data[i] < payload.data[j]
-		},
-		FnEqual: func(i, j int) bool {
-			return payload.data[i] == payload.data[j]
-		},
-	}
+	payload.initArrangeable()
 
 	return payload
 }
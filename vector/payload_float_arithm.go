@@ -12,7 +12,7 @@ func (p *floatPayload) Add(p2 Payload) Payload {
 	var opNA []bool
 	if pType, ok := p2.(*floatPayload); ok {
 		opNumbers = pType.data
-		opNA = pType.na
+		opNA = pType.IsNA()
 	} else if floatable, ok := p2.(Floatable); ok {
 		opNumbers, opNA = floatable.Floats()
 	} else {
@@ -20,7 +20,7 @@ func (p *floatPayload) Add(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || opNA[i] {
+		if p.na.at(i) || opNA[i] {
 			na[i] = true
 		} else {
 			numbers[i] = p.data[i] + opNumbers[i]
@@ -42,7 +42,7 @@ func (p *floatPayload) Sub(p2 Payload) Payload {
 	var subNA []bool
 	if pType, ok := p2.(*floatPayload); ok {
 		opNumbers = pType.data
-		subNA = pType.na
+		subNA = pType.IsNA()
 	} else if floatable, ok := p2.(Floatable); ok {
 		opNumbers, subNA = floatable.Floats()
 	} else {
@@ -50,7 +50,7 @@ func (p *floatPayload) Sub(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || subNA[i] {
+		if p.na.at(i) || subNA[i] {
 			na[i] = true
 		} else {
 			numbers[i] = p.data[i] - opNumbers[i]
@@ -72,7 +72,7 @@ func (p *floatPayload) Mul(p2 Payload) Payload {
 	var opNA []bool
 	if pType, ok := p2.(*floatPayload); ok {
 		opNumbers = pType.data
-		opNA = pType.na
+		opNA = pType.IsNA()
 	} else if floatable, ok := p2.(Floatable); ok {
 		opNumbers, opNA = floatable.Floats()
 	} else {
@@ -80,7 +80,7 @@ func (p *floatPayload) Mul(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || opNA[i] {
+		if p.na.at(i) || opNA[i] {
 			na[i] = true
 		} else {
 			numbers[i] = p.data[i] * opNumbers[i]
@@ -102,7 +102,7 @@ func (p *floatPayload) Div(p2 Payload) Payload {
 	var opNA []bool
 	if pType, ok := p2.(*floatPayload); ok {
 		opNumbers = pType.data
-		opNA = pType.na
+		opNA = pType.IsNA()
 	} else if floatable, ok := p2.(Floatable); ok {
 		opNumbers, opNA = floatable.Floats()
 	} else {
@@ -110,7 +110,7 @@ func (p *floatPayload) Div(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || opNA[i] || opNumbers[i] == 0 {
+		if p.na.at(i) || opNA[i] || opNumbers[i] == 0 {
 			na[i] = true
 		} else {
 			numbers[i] = p.data[i] / opNumbers[i]
@@ -48,9 +48,9 @@ func TestFloatPayload_Add(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -96,9 +96,9 @@ func TestFloatPayload_Sub(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -144,9 +144,9 @@ func TestFloatPayload_Mul(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -192,9 +192,9 @@ func TestFloatPayload_Div(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -61,3 +61,45 @@ func (p *floatPayload) CumMin() Payload {
 
 	return FloatPayload(data, na, p.Options()...)
 }
+
+func (p *floatPayload) Var() Payload {
+	variance, na := genVar(p.data, p.na)
+
+	return FloatPayload([]float64{variance}, []bool{na})
+}
+
+func (p *floatPayload) Sd() Payload {
+	sd, na := genSd(p.data, p.na)
+
+	return FloatPayload([]float64{sd}, []bool{na})
+}
+
+func (p *floatPayload) Quantile(probs []float64) Payload {
+	quantiles, na := genQuantile(p.data, p.na, probs)
+
+	return FloatPayload(quantiles, na)
+}
+
+func (p *floatPayload) IQR() Payload {
+	iqr, na := genIQR(p.data, p.na)
+
+	return FloatPayload([]float64{iqr}, []bool{na})
+}
+
+func (p *floatPayload) Mad() Payload {
+	mad, na := genMad(p.data, p.na)
+
+	return FloatPayload([]float64{mad}, []bool{na})
+}
+
+func (p *floatPayload) Skewness() Payload {
+	skewness, na := genSkewness(p.data, p.na)
+
+	return FloatPayload([]float64{skewness}, []bool{na})
+}
+
+func (p *floatPayload) Kurtosis() Payload {
+	kurtosis, na := genKurtosis(p.data, p.na)
+
+	return FloatPayload([]float64{kurtosis}, []bool{na})
+}
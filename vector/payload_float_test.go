@@ -89,7 +89,7 @@ func TestFloat(t *testing.T) {
 			vv := v.(*vector)
 
 			if data.isEmpty {
-				naPayload, ok := vv.payload.(*naPayload)
+				naPayload, ok := vv.Payload().(*naPayload)
 				if !ok || naPayload.Len() > 0 {
 					t.Error("Vector's payload is not empty")
 				}
@@ -99,7 +99,7 @@ func TestFloat(t *testing.T) {
 					t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", vv.length, length))
 				}
 
-				payload, ok := vv.payload.(*floatPayload)
+				payload, ok := vv.Payload().(*floatPayload)
 				if !ok {
 					t.Error("Payload is not floatPayload")
 				} else {
@@ -110,13 +110,13 @@ func TestFloat(t *testing.T) {
 				}
 
 				if len(data.na) > 0 && len(data.na) == length {
-					if !reflect.DeepEqual(payload.na, data.na) {
+					if !reflect.DeepEqual(payload.IsNA(), data.na) {
 						t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-							payload.na, data.na))
+							payload.IsNA(), data.na))
 					}
 				} else if len(data.na) == 0 {
-					if !reflect.DeepEqual(payload.na, emptyNA) {
-						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+					if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 					}
 				} else {
 					t.Error("error")
@@ -146,7 +146,7 @@ func TestFloatPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := FloatWithNA(data.in, nil).(*vector).payload
+			payload := FloatWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -185,7 +185,7 @@ func TestFloatPayload_Booleans(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.out) {
@@ -234,7 +234,7 @@ func TestFloatPayload_Integers(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.out) {
@@ -277,7 +277,7 @@ func TestFloatPayload_Interfaces(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.out) {
@@ -320,7 +320,7 @@ func TestFloatPayload_Floats(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			floats, na := payload.Floats()
 			if !util.EqualFloatArrays(floats, data.out) {
@@ -363,7 +363,7 @@ func TestFloatPayload_Complexes(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			complexes, na := payload.Complexes()
 			if !util.EqualComplexArrays(complexes, data.out) {
@@ -406,7 +406,7 @@ func TestFloatPayload_Strings(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := FloatWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*floatPayload)
+			payload := vec.(*vector).Payload().(*floatPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.out) {
@@ -455,11 +455,11 @@ func TestFloatPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*floatPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*floatPayload)
 			if !util.EqualFloatArrays(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -494,7 +494,7 @@ func TestFloatPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := FloatWithNA([]float64{1}, nil).(*vector).payload.(Whichable)
+	payload := FloatWithNA([]float64{1}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -537,7 +537,7 @@ func TestFloatPayload_Whicher(t *testing.T) {
 		},
 	}
 
-	payload := FloatWithNA([]float64{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).payload.(Whichable)
+	payload := FloatWithNA([]float64{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -619,7 +619,7 @@ func TestFloatPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := FloatWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := FloatWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*floatPayload)
@@ -627,9 +627,9 @@ func TestFloatPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						payloadOut.data, data.dataOut))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						payloadOut.na, data.naOut))
+						payloadOut.IsNA(), data.naOut))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -659,7 +659,7 @@ func TestFloatPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := ComplexWithNA([]complex128{}, nil).(*vector).payload.(Summarizable)
+	payload := ComplexWithNA([]complex128{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -714,16 +714,16 @@ func TestFloatPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := FloatWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := FloatWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*floatPayload)
 			if !util.EqualFloatArrays(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -766,9 +766,9 @@ func TestFloatPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -814,9 +814,9 @@ func TestFloatPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPaylout.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPaylout.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPaylout.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPaylout.na))
+					outPayload.IsNA(), data.outPaylout.IsNA()))
 			}
 		})
 	}
@@ -1185,9 +1185,9 @@ func TestFloatPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1335,9 +1335,9 @@ func TestFloatPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -4,6 +4,7 @@ import (
 	"math"
 	"math/cmplx"
 	"strconv"
+	"time"
 )
 
 // integerPayload is a structure, subsisting Integer vectors
@@ -36,6 +37,29 @@ func (p *integerPayload) ByIndices(indices []int) Payload {
 	return IntegerPayload(data, na, p.Options()...)
 }
 
+func (p *integerPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *integerPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
 func (p *integerPayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[int](whicher)
 }
@@ -81,7 +105,7 @@ func (p *integerPayload) Integers() ([]int, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -94,7 +118,7 @@ func (p *integerPayload) Floats() ([]float64, []bool) {
 	data := make([]float64, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = math.NaN()
 		} else {
 			data[i] = float64(p.data[i])
@@ -102,7 +126,7 @@ func (p *integerPayload) Floats() ([]float64, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -114,7 +138,7 @@ func (p *integerPayload) Complexes() ([]complex128, []bool) {
 
 	data := make([]complex128, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = cmplx.NaN()
 		} else {
 			data[i] = complex(float64(p.data[i]), 0)
@@ -122,7 +146,7 @@ func (p *integerPayload) Complexes() ([]complex128, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -135,7 +159,7 @@ func (p *integerPayload) Booleans() ([]bool, []bool) {
 	data := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = false
 		} else {
 			data[i] = p.data[i] != 0
@@ -143,7 +167,7 @@ func (p *integerPayload) Booleans() ([]bool, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -156,7 +180,7 @@ func (p *integerPayload) Strings() ([]string, []bool) {
 	data := make([]string, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = ""
 		} else {
 			data[i] = strconv.Itoa(p.data[i])
@@ -164,7 +188,26 @@ func (p *integerPayload) Strings() ([]string, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
+
+	return data, na
+}
+
+// Durations treats integers as nanoseconds.
+func (p *integerPayload) Durations() ([]time.Duration, []bool) {
+	if p.length == 0 {
+		return []time.Duration{}, []bool{}
+	}
+
+	data := make([]time.Duration, p.length)
+	for i, val := range p.data {
+		if !p.na.at(i) {
+			data[i] = time.Duration(val)
+		}
+	}
+
+	na := make([]bool, p.length)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -176,7 +219,7 @@ func (p *integerPayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -184,7 +227,7 @@ func (p *integerPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -206,7 +249,7 @@ func (p *integerPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return IntegerPayload(newVals, newNA, p.Options()...)
@@ -243,7 +286,7 @@ func (p *integerPayload) Groups() ([][]int, []any) {
 }
 
 func (p *integerPayload) StrForElem(idx int) string {
-	if p.na[idx-1] {
+	if p.na.at(idx - 1) {
 		return "NA"
 	}
 
@@ -343,7 +386,7 @@ func (p *integerPayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -371,7 +414,7 @@ func (p *integerPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*integerPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if intable, ok := payload.(Intable); ok {
 		srcData, srcNA = intable.Integers()
 	} else {
@@ -382,12 +425,12 @@ func (p *integerPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -426,23 +469,12 @@ func IntegerPayload(data []int, na []bool, _ ...Option) Payload {
 	}
 
 	payload := &integerPayload{
-		length: length,
-		data:   vecData,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		DefNAble: newDefNAble(vecNA),
 	}
 
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess: func(i, j int) bool {
-			return payload.data[i] < payload.data[j]
-		},
-		FnEqual: func(i, j int) bool {
-			return payload.data[i] == payload.data[j]
-		},
-	}
+	payload.initArrangeable()
 
 	return payload
 }
@@ -1,6 +1,12 @@
 package vector
 
+// Add adds integers of another payload. If another payload contains dates, the integers are added to the dates
+// as numbers of days and a date payload is returned.
 func (p *integerPayload) Add(p2 Payload) Payload {
+	if p.promotedToFloat(p2) {
+		return p.floatArithm(p2, narrowOpAdd)
+	}
+
 	integers := make([]int, p.length)
 	na := make([]bool, p.length)
 
@@ -8,11 +14,15 @@ func (p *integerPayload) Add(p2 Payload) Payload {
 		p2 = p2.Adjust(p.length)
 	}
 
+	if dates, ok := p2.(*datePayload); ok {
+		return dates.Add(p)
+	}
+
 	var addIntegers []int
 	var addNA []bool
 	if pType, ok := p2.(*integerPayload); ok {
 		addIntegers = pType.data
-		addNA = pType.na
+		addNA = pType.IsNA()
 	} else if intable, ok := p2.(Intable); ok {
 		addIntegers, addNA = intable.Integers()
 	} else {
@@ -20,7 +30,7 @@ func (p *integerPayload) Add(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || addNA[i] {
+		if p.na.at(i) || addNA[i] {
 			na[i] = true
 		} else {
 			integers[i] = p.data[i] + addIntegers[i]
@@ -31,6 +41,10 @@ func (p *integerPayload) Add(p2 Payload) Payload {
 }
 
 func (p *integerPayload) Sub(p2 Payload) Payload {
+	if p.promotedToFloat(p2) {
+		return p.floatArithm(p2, narrowOpSub)
+	}
+
 	integers := make([]int, p.length)
 	na := make([]bool, p.length)
 
@@ -42,7 +56,7 @@ func (p *integerPayload) Sub(p2 Payload) Payload {
 	var subNA []bool
 	if pType, ok := p2.(*integerPayload); ok {
 		subIntegers = pType.data
-		subNA = pType.na
+		subNA = pType.IsNA()
 	} else if intable, ok := p2.(Intable); ok {
 		subIntegers, subNA = intable.Integers()
 	} else {
@@ -50,7 +64,7 @@ func (p *integerPayload) Sub(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || subNA[i] {
+		if p.na.at(i) || subNA[i] {
 			na[i] = true
 		} else {
 			integers[i] = p.data[i] - subIntegers[i]
@@ -61,6 +75,10 @@ func (p *integerPayload) Sub(p2 Payload) Payload {
 }
 
 func (p *integerPayload) Mul(p2 Payload) Payload {
+	if p.promotedToFloat(p2) {
+		return p.floatArithm(p2, narrowOpMul)
+	}
+
 	integers := make([]int, p.length)
 	na := make([]bool, p.length)
 
@@ -72,7 +90,7 @@ func (p *integerPayload) Mul(p2 Payload) Payload {
 	var mulNA []bool
 	if pType, ok := p2.(*integerPayload); ok {
 		mulIntegers = pType.data
-		mulNA = pType.na
+		mulNA = pType.IsNA()
 	} else if intable, ok := p2.(Intable); ok {
 		mulIntegers, mulNA = intable.Integers()
 	} else {
@@ -80,7 +98,7 @@ func (p *integerPayload) Mul(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || mulNA[i] {
+		if p.na.at(i) || mulNA[i] {
 			na[i] = true
 		} else {
 			integers[i] = p.data[i] * mulIntegers[i]
@@ -91,6 +109,10 @@ func (p *integerPayload) Mul(p2 Payload) Payload {
 }
 
 func (p *integerPayload) Div(p2 Payload) Payload {
+	if p.promotedToFloat(p2) {
+		return p.floatArithm(p2, narrowOpDiv)
+	}
+
 	integers := make([]int, p.length)
 	na := make([]bool, p.length)
 
@@ -102,7 +124,7 @@ func (p *integerPayload) Div(p2 Payload) Payload {
 	var divNA []bool
 	if pType, ok := p2.(*integerPayload); ok {
 		divIntegers = pType.data
-		divNA = pType.na
+		divNA = pType.IsNA()
 	} else if intable, ok := p2.(Intable); ok {
 		divIntegers, divNA = intable.Integers()
 	} else {
@@ -110,7 +132,7 @@ func (p *integerPayload) Div(p2 Payload) Payload {
 	}
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] || divNA[i] || divIntegers[i] == 0 {
+		if p.na.at(i) || divNA[i] || divIntegers[i] == 0 {
 			na[i] = true
 		} else {
 			integers[i] = p.data[i] / divIntegers[i]
@@ -119,3 +141,16 @@ func (p *integerPayload) Div(p2 Payload) Payload {
 
 	return IntegerPayload(integers, na, p.Options()...)
 }
+
+// promotedToFloat checks if an operation with the narrow payload has to be performed in float according to
+// the promotion table (integer with float32). Operations with float payloads keep giving integer payloads.
+func (p *integerPayload) promotedToFloat(p2 Payload) bool {
+	return p2.Type() != PayloadTypeFloat && promotedType(p.Type(), p2.Type()) == PayloadTypeFloat
+}
+
+// floatArithm performs the operation in float for operands which are promoted to float (like float32).
+func (p *integerPayload) floatArithm(p2 Payload, op int) Payload {
+	floats, na := p.Floats()
+
+	return invokeArithm(FloatPayload(floats, na, p2.Options()...), p2, op)
+}
@@ -59,3 +59,45 @@ func (p *integerPayload) CumMin() Payload {
 
 	return IntegerPayload(data, na, p.Options()...)
 }
+
+func (p *integerPayload) Var() Payload {
+	variance, na := genVar(p.data, p.na)
+
+	return FloatPayload([]float64{variance}, []bool{na})
+}
+
+func (p *integerPayload) Sd() Payload {
+	sd, na := genSd(p.data, p.na)
+
+	return FloatPayload([]float64{sd}, []bool{na})
+}
+
+func (p *integerPayload) Quantile(probs []float64) Payload {
+	quantiles, na := genQuantile(p.data, p.na, probs)
+
+	return FloatPayload(quantiles, na)
+}
+
+func (p *integerPayload) IQR() Payload {
+	iqr, na := genIQR(p.data, p.na)
+
+	return FloatPayload([]float64{iqr}, []bool{na})
+}
+
+func (p *integerPayload) Mad() Payload {
+	mad, na := genMad(p.data, p.na)
+
+	return FloatPayload([]float64{mad}, []bool{na})
+}
+
+func (p *integerPayload) Skewness() Payload {
+	skewness, na := genSkewness(p.data, p.na)
+
+	return FloatPayload([]float64{skewness}, []bool{na})
+}
+
+func (p *integerPayload) Kurtosis() Payload {
+	kurtosis, na := genKurtosis(p.data, p.na)
+
+	return FloatPayload([]float64{kurtosis}, []bool{na})
+}
@@ -64,7 +64,7 @@ func TestInteger(t *testing.T) {
 			vv := v.(*vector)
 
 			if data.isEmpty {
-				naPayload, ok := vv.payload.(*naPayload)
+				naPayload, ok := vv.Payload().(*naPayload)
 				if !ok || naPayload.Len() > 0 {
 					t.Error("Vector's payload is not empty")
 				}
@@ -74,7 +74,7 @@ func TestInteger(t *testing.T) {
 					t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", vv.length, length))
 				}
 
-				payload, ok := vv.payload.(*integerPayload)
+				payload, ok := vv.Payload().(*integerPayload)
 				if !ok {
 					t.Error("Payload is not integerPayload")
 				} else {
@@ -85,13 +85,13 @@ func TestInteger(t *testing.T) {
 				}
 
 				if len(data.na) > 0 && len(data.na) == length {
-					if !reflect.DeepEqual(payload.na, data.na) {
+					if !reflect.DeepEqual(payload.IsNA(), data.na) {
 						t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-							payload.na, data.na))
+							payload.IsNA(), data.na))
 					}
 				} else if len(data.na) == 0 {
-					if !reflect.DeepEqual(payload.na, emptyNA) {
-						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+					if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 					}
 				} else {
 					t.Error("error")
@@ -121,7 +121,7 @@ func TestIntegerPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := IntegerWithNA(data.in, nil).(*vector).payload
+			payload := IntegerWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -160,7 +160,7 @@ func TestIntegerPayload_Booleans(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.out) {
@@ -203,7 +203,7 @@ func TestIntegerPayload_Integers(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.out) {
@@ -246,7 +246,7 @@ func TestIntegerPayload_Interfaces(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.out) {
@@ -289,7 +289,7 @@ func TestIntegerPayload_Floats(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			floats, na := payload.Floats()
 			if !util.EqualFloatArrays(floats, data.out) {
@@ -332,7 +332,7 @@ func TestIntegerPayload_Complexes(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			complexes, na := payload.Complexes()
 			if !util.EqualComplexArrays(complexes, data.out) {
@@ -375,7 +375,7 @@ func TestIntegerPayload_Strings(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := IntegerWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*integerPayload)
+			payload := vec.(*vector).Payload().(*integerPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.out) {
@@ -424,11 +424,11 @@ func TestIntegerPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*integerPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*integerPayload)
 			if !reflect.DeepEqual(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -463,7 +463,7 @@ func TestIntegerPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := IntegerWithNA([]int{1}, nil).(*vector).payload.(Whichable)
+	payload := IntegerWithNA([]int{1}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -526,7 +526,7 @@ func TestIntegerPayload_Whicher(t *testing.T) {
 		},
 	}
 
-	payload := IntegerWithNA([]int{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).payload.(Whichable)
+	payload := IntegerWithNA([]int{1, 2, 39, 4, 56, 2, 45, 90, 4, 3}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -609,7 +609,7 @@ func TestIntegerPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := IntegerWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := IntegerWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*integerPayload)
@@ -617,9 +617,9 @@ func TestIntegerPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -649,7 +649,7 @@ func TestIntegerPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := IntegerWithNA([]int{}, nil).(*vector).payload.(Summarizable)
+	payload := IntegerWithNA([]int{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -704,16 +704,16 @@ func TestIntegerPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := IntegerWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := IntegerWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*integerPayload)
 			if !reflect.DeepEqual(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -756,9 +756,9 @@ func TestIntegerPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -803,9 +803,9 @@ func TestIntegerPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPaylout.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPaylout.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPaylout.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPaylout.na))
+					outPayload.IsNA(), data.outPaylout.IsNA()))
 			}
 		})
 	}
@@ -1153,9 +1153,9 @@ func TestIntegerPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1308,9 +1308,9 @@ func TestIntegerPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -38,7 +38,7 @@ func (p *naPayload) ByIndices(indices []int) Payload {
 	length := 0
 
 	for _, idx := range indices {
-		if idx >= 1 && idx <= p.length {
+		if idx >= 0 && idx <= p.length {
 			length++
 		}
 	}
@@ -166,6 +166,20 @@ func (p *naPayload) Times() ([]time.Time, []bool) {
 	return data, p.naArray()
 }
 
+func (p *naPayload) Dates() ([]time.Time, []bool) {
+	return p.Times()
+}
+
+func (p *naPayload) Durations() ([]time.Duration, []bool) {
+	if p.length == 0 {
+		return []time.Duration{}, []bool{}
+	}
+
+	data := make([]time.Duration, p.length)
+
+	return data, p.naArray()
+}
+
 func (p *naPayload) Anies() ([]any, []bool) {
 	if p.length == 0 {
 		return []any{}, []bool{}
@@ -36,7 +36,7 @@ func TestNA(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := NA(data.inLength).(*vector).payload.(*naPayload)
+			payload := NA(data.inLength).(*vector).Payload().(*naPayload)
 			if payload.length != data.outLength {
 				t.Error(fmt.Sprintf("payload.length (%d) is not equal to expected (%d)",
 					payload.length, data.outLength))
@@ -99,7 +99,7 @@ func TestNaPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.inLength).(*vector).payload
+			payload := NA(data.inLength).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -129,7 +129,7 @@ func TestNaPayload_ByIndices(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(5).ByIndices(data.indices).(*vector).payload
+			payload := NA(5).ByIndices(data.indices).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -159,7 +159,7 @@ func TestNaPayload_IsNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			na := payload.IsNA()
 			if !reflect.DeepEqual(na, data.out) {
 				t.Error(fmt.Sprintf("payload.isNA() (%v) is not equal to expected (%v)",
@@ -190,7 +190,7 @@ func TestNaPayload_NotNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			notNA := payload.NotNA()
 			if !reflect.DeepEqual(notNA, data.out) {
 				t.Error(fmt.Sprintf("payload.notNA() (%v) is not equal to expected (%v)",
@@ -210,7 +210,7 @@ func TestNaPayload_HasNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			hasNA := payload.HasNA()
 			if !reflect.DeepEqual(hasNA, data.hasNA) {
 				t.Error(fmt.Sprintf("payload.hasNA() (%v) is not equal to expected (%v)",
@@ -241,7 +241,7 @@ func TestNaPayload_WithNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			withNA := payload.WithNA()
 			if !reflect.DeepEqual(withNA, data.out) {
 				t.Error(fmt.Sprintf("payload.withNA() (%v) is not equal to expected (%v)",
@@ -272,7 +272,7 @@ func TestNaPayload_WithoutNA(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			withoutNA := payload.WithoutNA()
 			if !reflect.DeepEqual(withoutNA, data.out) {
 				t.Error(fmt.Sprintf("payload.withoutNA() (%v) is not equal to expected (%v)",
@@ -307,7 +307,7 @@ func TestNaPayload_Integers(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.outData) {
 				t.Error(fmt.Sprintf("Integers (%v) are not equal to expected (%v)",
@@ -346,7 +346,7 @@ func TestNaPayload_Floats(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			floats, na := payload.Floats()
 			if !util.EqualFloatArrays(floats, data.outData) {
 				t.Error(fmt.Sprintf("Floats (%v) are not equal to expected (%v)",
@@ -385,7 +385,7 @@ func TestNaPayload_Complexes(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			complexes, na := payload.Complexes()
 			if !util.EqualComplexArrays(complexes, data.outData) {
 				t.Error(fmt.Sprintf("Complexes (%v) are not equal to expected (%v)",
@@ -424,7 +424,7 @@ func TestNaPayload_Strings(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.outData) {
 				t.Error(fmt.Sprintf("Strings (%v) are not equal to expected (%v)",
@@ -463,7 +463,7 @@ func TestNaPayload_Booleans(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.outData) {
 				t.Error(fmt.Sprintf("Booleans (%v) are not equal to expected (%v)",
@@ -502,7 +502,7 @@ func TestNaPayload_Times(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 			times, na := payload.Times()
 			if !reflect.DeepEqual(times, data.outData) {
 				t.Error(fmt.Sprintf("Times (%v) are not equal to expected (%v)",
@@ -541,7 +541,7 @@ func TestNaPayload_Interfaces(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := NA(data.length).(*vector).payload.(*naPayload)
+			payload := NA(data.length).(*vector).Payload().(*naPayload)
 
 			interfaces, na := payload.Anies()
 			if !reflect.DeepEqual(interfaces, data.outData) {
@@ -557,7 +557,7 @@ func TestNaPayload_Interfaces(t *testing.T) {
 }
 
 func TestNaPayload_StrForElem(t *testing.T) {
-	payload := NA(5).(*vector).payload.(*naPayload)
+	payload := NA(5).(*vector).Payload().(*naPayload)
 
 	for i := 1; i <= 5; i++ {
 		if payload.StrForElem(i) != "NA" {
@@ -671,9 +671,9 @@ func TestNaPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
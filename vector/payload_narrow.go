@@ -0,0 +1,589 @@
+package vector
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+// narrow is a set of numeric types which are stored with their own width instead of int or float64.
+type narrow interface {
+	int32 | int64 | uint8 | float32
+}
+
+// narrowPayload is a payload for numeric types which are narrower than (or explicitly sized in contrast to) int and
+// float64. It is used by Int32, Int64, Uint8 and Float32 vectors.
+type narrowPayload[T narrow] struct {
+	length  int
+	data    []T
+	printer FloatPrinter
+	DefNAble
+	DefArrangeable
+}
+
+func (p *narrowPayload[T]) Type() string {
+	return narrowType[T]()
+}
+
+func (p *narrowPayload[T]) Len() int {
+	return p.length
+}
+
+func (p *narrowPayload[T]) Pick(idx int) any {
+	return pickValueWithNA(idx, p.data, p.na, p.length)
+}
+
+func (p *narrowPayload[T]) Data() []any {
+	return dataWithNAToInterfaceArray(p.data, p.na)
+}
+
+func (p *narrowPayload[T]) ByIndices(indices []int) Payload {
+	data, na := byIndicesWithNA(indices, p.data, p.na, 0)
+
+	return newNarrowPayload(data, na, p.Options()...)
+}
+
+func (p *narrowPayload[T]) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *narrowPayload[T]) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
+func (p *narrowPayload[T]) SupportsWhicher(whicher any) bool {
+	return supportsWhicherWithNA[T](whicher)
+}
+
+func (p *narrowPayload[T]) Which(whicher any) []bool {
+	return whichWithNA(p.data, p.na, whicher)
+}
+
+func (p *narrowPayload[T]) Apply(applier any) Payload {
+	if data, na, ok := applyTypeWithNA[T, T](p.data, p.na, applier, 0); ok {
+		return newNarrowPayload(data, na, p.Options()...)
+	}
+
+	return applyWithNA(p.data, p.na, applier, p.Options())
+}
+
+func (p *narrowPayload[T]) Traverse(traverser any) {
+	traverseWithNA(p.data, p.na, traverser)
+}
+
+func (p *narrowPayload[T]) ApplyTo(indices []int, applier any) Payload {
+	data, na := applyToWithNA(indices, p.data, p.na, applier, 0)
+
+	if data == nil {
+		return NAPayload(p.length)
+	}
+
+	return newNarrowPayload(data, na, p.Options()...)
+}
+
+func (p *narrowPayload[T]) SupportsSummarizer(summarizer any) bool {
+	return supportsSummarizer[T](summarizer)
+}
+
+func (p *narrowPayload[T]) Summarize(summarizer any) Payload {
+	val, na := summarize(p.data, p.na, summarizer, 0, 0)
+
+	return newNarrowPayload([]T{val}, []bool{na}, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Integers() ([]int, []bool) {
+	if p.length == 0 {
+		return []int{}, []bool{}
+	}
+
+	data := make([]int, p.length)
+	na := p.IsNA()
+	for i, val := range p.data {
+		if na[i] {
+			continue
+		}
+
+		float := float64(val)
+		if math.IsNaN(float) || math.IsInf(float, 0) {
+			na[i] = true
+		} else {
+			data[i] = int(val)
+		}
+	}
+
+	return data, na
+}
+
+func (p *narrowPayload[T]) Floats() ([]float64, []bool) {
+	if p.length == 0 {
+		return []float64{}, []bool{}
+	}
+
+	data := make([]float64, p.length)
+	for i, val := range p.data {
+		if p.na.at(i) {
+			data[i] = math.NaN()
+		} else {
+			data[i] = float64(val)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *narrowPayload[T]) Complexes() ([]complex128, []bool) {
+	if p.length == 0 {
+		return []complex128{}, []bool{}
+	}
+
+	data := make([]complex128, p.length)
+	for i, val := range p.data {
+		if p.na.at(i) {
+			data[i] = cmplx.NaN()
+		} else {
+			data[i] = complex(float64(val), 0)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *narrowPayload[T]) Booleans() ([]bool, []bool) {
+	if p.length == 0 {
+		return []bool{}, []bool{}
+	}
+
+	data := make([]bool, p.length)
+	for i, val := range p.data {
+		data[i] = !p.na.at(i) && val != 0
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *narrowPayload[T]) Strings() ([]string, []bool) {
+	if p.length == 0 {
+		return []string{}, []bool{}
+	}
+
+	data := make([]string, p.length)
+	for i := 0; i < p.length; i++ {
+		if !p.na.at(i) {
+			data[i] = p.StrForElem(i + 1)
+		}
+	}
+
+	return data, p.IsNA()
+}
+
+func (p *narrowPayload[T]) Anies() ([]any, []bool) {
+	if p.length == 0 {
+		return []any{}, []bool{}
+	}
+
+	return p.Data(), p.IsNA()
+}
+
+func (p *narrowPayload[T]) StrForElem(idx int) string {
+	if p.na.at(idx - 1) {
+		return "NA"
+	}
+
+	switch val := any(p.data[idx-1]).(type) {
+	case float32:
+		float := float64(val)
+		if math.IsInf(float, +1) {
+			return "+Inf"
+		}
+		if math.IsInf(float, -1) {
+			return "-Inf"
+		}
+		if math.IsNaN(float) {
+			return "NaN"
+		}
+
+		return strconv.FormatFloat(float, 'f', p.printer.Precision, 32)
+	}
+
+	return strconv.FormatInt(int64(p.data[idx-1]), 10)
+}
+
+func (p *narrowPayload[T]) Append(payload Payload) Payload {
+	length := p.length + payload.Len()
+
+	vals, na := narrowValuesOf[T](payload)
+
+	newVals := make([]T, length)
+	newNA := make([]bool, length)
+
+	copy(newVals, p.data)
+	copy(newVals[p.length:], vals)
+	p.na.copyTo(newNA)
+	copy(newNA[p.length:], na)
+
+	return newNarrowPayload(newVals, newNA, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Adjust(size int) Payload {
+	if size < p.length {
+		data, na := adjustToLesserSizeWithNA(p.data, p.na, size)
+
+		return newNarrowPayload(data, na, p.Options()...)
+	}
+
+	if size > p.length {
+		data, na := adjustToBiggerSizeWithNA(p.data, p.na, p.length, size)
+
+		return newNarrowPayload(data, na, p.Options()...)
+	}
+
+	return p
+}
+
+func (p *narrowPayload[T]) Groups() ([][]int, []any) {
+	groups, values := groupsForData(p.data, p.na)
+
+	return groups, values
+}
+
+/* Finder interface */
+
+func (p *narrowPayload[T]) Find(needle any) int {
+	return find(needle, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) FindAll(needle any) []int {
+	return findAll(needle, p.data, p.na, p.convertComparator)
+}
+
+/* Ordered interface */
+
+func (p *narrowPayload[T]) Eq(val any) []bool {
+	return eq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) Neq(val any) []bool {
+	return neq(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) Gt(val any) []bool {
+	return gt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) Lt(val any) []bool {
+	return lt(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) Gte(val any) []bool {
+	return gte(val, p.data, p.na, p.convertComparator)
+}
+
+func (p *narrowPayload[T]) Lte(val any) []bool {
+	return lte(val, p.data, p.na, p.convertComparator)
+}
+
+// convertComparator converts a number to the type of the payload. Numbers which can not be represented by the type
+// (like 256 or 1.5 for uint8) are not converted.
+func (p *narrowPayload[T]) convertComparator(val any) (T, bool) {
+	switch v := val.(type) {
+	case int:
+		return narrowFromInt64[T](int64(v))
+	case int64:
+		return narrowFromInt64[T](v)
+	case int32:
+		return narrowFromInt64[T](int64(v))
+	case int16:
+		return narrowFromInt64[T](int64(v))
+	case int8:
+		return narrowFromInt64[T](int64(v))
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, false
+		}
+		return narrowFromInt64[T](int64(v))
+	case uint32:
+		return narrowFromInt64[T](int64(v))
+	case uint16:
+		return narrowFromInt64[T](int64(v))
+	case uint8:
+		return narrowFromInt64[T](int64(v))
+	case float64:
+		return narrowFromFloat64[T](v)
+	case float32:
+		return narrowFromFloat64[T](float64(v))
+	}
+
+	return 0, false
+}
+
+func (p *narrowPayload[T]) IsUnique() []bool {
+	booleans := make([]bool, p.length)
+
+	valuesMap := map[T]bool{}
+	wasNA := false
+	wasNaN := false
+	for i := 0; i < p.length; i++ {
+		is := false
+
+		if p.na.at(i) {
+			if !wasNA {
+				is = true
+				wasNA = true
+			}
+		} else if p.data[i] != p.data[i] {
+			if !wasNaN {
+				is = true
+				wasNaN = true
+			}
+		} else {
+			if _, ok := valuesMap[p.data[i]]; !ok {
+				is = true
+				valuesMap[p.data[i]] = true
+			}
+		}
+
+		booleans[i] = is
+	}
+
+	return booleans
+}
+
+func (p *narrowPayload[T]) Coalesce(payload Payload) Payload {
+	if p.length != payload.Len() {
+		payload = payload.Adjust(p.length)
+	}
+
+	srcData, srcNA := narrowValuesOf[T](payload)
+
+	dstData := make([]T, p.length)
+	dstNA := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) && !srcNA[i] {
+			dstData[i] = srcData[i]
+			dstNA[i] = false
+		} else {
+			dstData[i] = p.data[i]
+			dstNA[i] = p.na.at(i)
+		}
+	}
+
+	return newNarrowPayload(dstData, dstNA, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Options() []Option {
+	if !isNarrowFloat[T]() {
+		return []Option{}
+	}
+
+	return []Option{
+		ConfOption{keyOptionPrecision, p.printer.Precision},
+	}
+}
+
+func (p *narrowPayload[T]) SetOption(name string, val any) bool {
+	if name == keyOptionPrecision && isNarrowFloat[T]() {
+		p.printer.Precision = val.(int)
+
+		return true
+	}
+
+	return false
+}
+
+func narrowType[T narrow]() string {
+	switch any(T(0)).(type) {
+	case int32:
+		return PayloadTypeInt32
+	case int64:
+		return PayloadTypeInt64
+	case uint8:
+		return PayloadTypeUint8
+	}
+
+	return PayloadTypeFloat32
+}
+
+func isNarrowFloat[T narrow]() bool {
+	_, ok := any(T(0)).(float32)
+
+	return ok
+}
+
+func narrowFromInt64[T narrow](val int64) (T, bool) {
+	converted := T(val)
+	if !isNarrowFloat[T]() && int64(converted) != val {
+		return 0, false
+	}
+
+	return converted, true
+}
+
+func narrowFromFloat64[T narrow](val float64) (T, bool) {
+	if isNarrowFloat[T]() {
+		return T(val), true
+	}
+
+	if val != math.Trunc(val) || val < math.MinInt64 || val >= math.MaxInt64 {
+		return 0, false
+	}
+
+	return narrowFromInt64[T](int64(val))
+}
+
+// narrowValuesOf converts values of a payload to the narrow type. Floats are taken for float32 and integers for
+// integer types. Integers which do not fit the type become NA.
+func narrowValuesOf[T narrow](payload Payload) ([]T, []bool) {
+	if same, ok := payload.(*narrowPayload[T]); ok {
+		data := make([]T, same.length)
+		copy(data, same.data)
+
+		return data, same.IsNA()
+	}
+
+	if isNarrowFloat[T]() {
+		if floatable, ok := payload.(Floatable); ok {
+			return narrowConvert[float64, T](floatable.Floats())
+		}
+	} else if intable, ok := payload.(Intable); ok {
+		return narrowConvert[int, T](intable.Integers())
+	}
+
+	return make([]T, payload.Len()), NAPayload(payload.Len()).(NAble).IsNA()
+}
+
+func narrowConvert[S int | float64, T narrow](data []S, na []bool) ([]T, []bool) {
+	checkRange := !isNarrowFloat[T]()
+
+	converted := make([]T, len(data))
+	convertedNA := make([]bool, len(data))
+	for i, val := range data {
+		if na[i] {
+			convertedNA[i] = true
+			continue
+		}
+
+		converted[i] = T(val)
+		if checkRange && S(converted[i]) != val {
+			converted[i] = 0
+			convertedNA[i] = true
+		}
+	}
+
+	return converted, convertedNA
+}
+
+func newNarrowPayload[T narrow](data []T, na []bool, options ...Option) Payload {
+	length := len(data)
+	conf := MergeOptions(options)
+
+	vecNA := make([]bool, length)
+	if len(na) > 0 {
+		if len(na) == length {
+			copy(vecNA, na)
+		} else {
+			emp := NAPayload(0)
+			return emp
+		}
+	}
+
+	vecData := make([]T, length)
+	for i := 0; i < length; i++ {
+		if !vecNA[i] {
+			vecData[i] = data[i]
+		}
+	}
+
+	payload := &narrowPayload[T]{
+		length: length,
+		data:   vecData,
+		printer: FloatPrinter{
+			Precision: 3,
+		},
+		DefNAble: newDefNAble(vecNA),
+	}
+
+	conf.SetOptions(payload)
+
+	payload.initArrangeable()
+
+	return payload
+}
+
+// Int32Payload creates a payload with int32 data.
+func Int32Payload(data []int32, na []bool, options ...Option) Payload {
+	return newNarrowPayload(data, na, options...)
+}
+
+// Int32WithNA creates a vector with Int32Payload and allows to set NA-values.
+func Int32WithNA(data []int32, na []bool, options ...Option) Vector {
+	return New(Int32Payload(data, na, options...), options...)
+}
+
+// Int32 creates a vector with Int32Payload.
+func Int32(data []int32, options ...Option) Vector {
+	return Int32WithNA(data, nil, options...)
+}
+
+// Int64Payload creates a payload with int64 data.
+func Int64Payload(data []int64, na []bool, options ...Option) Payload {
+	return newNarrowPayload(data, na, options...)
+}
+
+// Int64WithNA creates a vector with Int64Payload and allows to set NA-values.
+func Int64WithNA(data []int64, na []bool, options ...Option) Vector {
+	return New(Int64Payload(data, na, options...), options...)
+}
+
+// Int64 creates a vector with Int64Payload.
+func Int64(data []int64, options ...Option) Vector {
+	return Int64WithNA(data, nil, options...)
+}
+
+// Uint8Payload creates a payload with uint8 data.
+func Uint8Payload(data []uint8, na []bool, options ...Option) Payload {
+	return newNarrowPayload(data, na, options...)
+}
+
+// Uint8WithNA creates a vector with Uint8Payload and allows to set NA-values.
+func Uint8WithNA(data []uint8, na []bool, options ...Option) Vector {
+	return New(Uint8Payload(data, na, options...), options...)
+}
+
+// Uint8 creates a vector with Uint8Payload.
+func Uint8(data []uint8, options ...Option) Vector {
+	return Uint8WithNA(data, nil, options...)
+}
+
+// Float32Payload creates a payload with float32 data.
+//
+// Available options are:
+//   - OptionPrecision(precision int) - sets precision for printing payload's values.
+func Float32Payload(data []float32, na []bool, options ...Option) Payload {
+	return newNarrowPayload(data, na, options...)
+}
+
+// Float32WithNA creates a vector with Float32Payload and allows to set NA-values.
+func Float32WithNA(data []float32, na []bool, options ...Option) Vector {
+	return New(Float32Payload(data, na, options...), options...)
+}
+
+// Float32 creates a vector with Float32Payload.
+func Float32(data []float32, options ...Option) Vector {
+	return Float32WithNA(data, nil, options...)
+}
@@ -0,0 +1,153 @@
+package vector
+
+// narrowWidths orders numeric payload types by their width.
+var narrowWidths = map[string]int{
+	PayloadTypeUint8:   1,
+	PayloadTypeInt32:   2,
+	PayloadTypeInt64:   3,
+	PayloadTypeInteger: 4,
+	PayloadTypeFloat32: 5,
+	PayloadTypeFloat:   6,
+	PayloadTypeComplex: 7,
+}
+
+// promotedType returns the type of the result of an arithmetic operation of two numeric payloads. It is the type
+// of the wider one, except float32 mixed with an integer type wider than 16 bits which gives float, because float32
+// can't represent all their values. An empty string is returned if one of the types is not numeric.
+func promotedType(t1, t2 string) string {
+	w1, ok1 := narrowWidths[t1]
+	w2, ok2 := narrowWidths[t2]
+	if !ok1 || !ok2 {
+		return ""
+	}
+
+	if w1 < w2 {
+		t1, t2 = t2, t1
+	}
+
+	if t1 == PayloadTypeFloat32 && t2 != PayloadTypeFloat32 && t2 != PayloadTypeUint8 {
+		return PayloadTypeFloat
+	}
+
+	return t1
+}
+
+const (
+	narrowOpAdd = iota
+	narrowOpSub
+	narrowOpMul
+	narrowOpDiv
+)
+
+// Add adds another payload. Integers are added to dates of another payload as numbers of days and a date payload
+// is returned.
+func (p *narrowPayload[T]) Add(p2 Payload) Payload {
+	if dates, ok := p2.(*datePayload); ok && p.Type() != PayloadTypeFloat32 {
+		if p.length != p2.Len() {
+			dates = dates.Adjust(p.length).(*datePayload)
+		}
+
+		return dates.Add(p)
+	}
+
+	return p.arithm(p2, narrowOpAdd)
+}
+
+func (p *narrowPayload[T]) Sub(p2 Payload) Payload {
+	return p.arithm(p2, narrowOpSub)
+}
+
+func (p *narrowPayload[T]) Mul(p2 Payload) Payload {
+	return p.arithm(p2, narrowOpMul)
+}
+
+// Div divides the payload by another one. Division by zero gives NA, integer types use integer division.
+func (p *narrowPayload[T]) Div(p2 Payload) Payload {
+	return p.arithm(p2, narrowOpDiv)
+}
+
+func (p *narrowPayload[T]) arithm(p2 Payload, op int) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	resultType := promotedType(p.Type(), p2.Type())
+	if resultType == "" {
+		resultType = p.Type()
+	}
+
+	switch resultType {
+	case PayloadTypeInt32:
+		return narrowArithm[int32](p, p2, op)
+	case PayloadTypeInt64:
+		return narrowArithm[int64](p, p2, op)
+	case PayloadTypeUint8:
+		return narrowArithm[uint8](p, p2, op)
+	case PayloadTypeFloat32:
+		return narrowArithm[float32](p, p2, op, p.Options()...)
+	case PayloadTypeInteger:
+		return invokeArithm(IntegerPayload(p.Integers()), p2, op)
+	case PayloadTypeFloat:
+		floats, na := p.Floats()
+		return invokeArithm(FloatPayload(floats, na, arithmOptions(p, p2, resultType)...), p2, op)
+	case PayloadTypeComplex:
+		complexes, na := p.Complexes()
+		return invokeArithm(ComplexPayload(complexes, na, arithmOptions(p, p2, resultType)...), p2, op)
+	}
+
+	return NAPayload(p.length)
+}
+
+// arithmOptions returns options of the operand of the result type or options of the first operand.
+func arithmOptions(p1 Payload, p2 Payload, resultType string) []Option {
+	if p2.Type() == resultType {
+		return p2.Options()
+	}
+
+	return p1.Options()
+}
+
+func narrowArithm[T narrow](p1 Payload, p2 Payload, op int, options ...Option) Payload {
+	data1, na1 := narrowValuesOf[T](p1)
+	data2, na2 := narrowValuesOf[T](p2)
+
+	data := make([]T, len(data1))
+	na := make([]bool, len(data1))
+
+	for i := range data1 {
+		if na1[i] || na2[i] {
+			na[i] = true
+			continue
+		}
+
+		switch op {
+		case narrowOpAdd:
+			data[i] = data1[i] + data2[i]
+		case narrowOpSub:
+			data[i] = data1[i] - data2[i]
+		case narrowOpMul:
+			data[i] = data1[i] * data2[i]
+		case narrowOpDiv:
+			if data2[i] == 0 {
+				na[i] = true
+			} else {
+				data[i] = data1[i] / data2[i]
+			}
+		}
+	}
+
+	return newNarrowPayload(data, na, options...)
+}
+
+func invokeArithm(p1 Payload, p2 Payload, op int) Payload {
+	switch op {
+	case narrowOpAdd:
+		return p1.(Adder).Add(p2)
+	case narrowOpSub:
+		return p1.(Subber).Sub(p2)
+	case narrowOpMul:
+		return p1.(Multiplier).Mul(p2)
+	}
+
+	return p1.(Divider).Div(p2)
+}
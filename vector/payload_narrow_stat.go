@@ -0,0 +1,118 @@
+package vector
+
+// Sum is accumulated in int (or float64 for float32), so the result is an integer (or float) payload which
+// doesn't overflow the narrow type.
+func (p *narrowPayload[T]) Sum() Payload {
+	return p.widened().(Summer).Sum()
+}
+
+// Prod is accumulated in the same way as Sum.
+func (p *narrowPayload[T]) Prod() Payload {
+	return p.widened().(Proder).Prod()
+}
+
+func (p *narrowPayload[T]) Max() Payload {
+	if p.length == 0 || p.HasNA() {
+		return newNarrowPayload([]T{0}, []bool{true}, p.Options()...)
+	}
+
+	max, _ := genMax(p.data, p.na)
+
+	return newNarrowPayload([]T{max}, []bool{false}, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Min() Payload {
+	if p.length == 0 || p.HasNA() {
+		return newNarrowPayload([]T{0}, []bool{true}, p.Options()...)
+	}
+
+	min, _ := genMin(p.data, p.na)
+
+	return newNarrowPayload([]T{min}, []bool{false}, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Mean() Payload {
+	mean, na := genMean(p.data, p.na)
+
+	return FloatPayload([]float64{mean}, []bool{na})
+}
+
+// Median returns a float payload, because the midpoint of two elements may be out of the narrow type.
+func (p *narrowPayload[T]) Median() Payload {
+	return FloatPayload(p.Floats()).(Medianer).Median()
+}
+
+// CumSum is accumulated in the same way as Sum.
+func (p *narrowPayload[T]) CumSum() Payload {
+	return p.widened().(CumSummer).CumSum()
+}
+
+// CumProd is accumulated in the same way as Sum.
+func (p *narrowPayload[T]) CumProd() Payload {
+	return p.widened().(CumProder).CumProd()
+}
+
+func (p *narrowPayload[T]) CumMax() Payload {
+	data, na := genCumMax(p.data, p.na, 0)
+
+	return newNarrowPayload(data, na, p.Options()...)
+}
+
+func (p *narrowPayload[T]) CumMin() Payload {
+	data, na := genCumMin(p.data, p.na, 0)
+
+	return newNarrowPayload(data, na, p.Options()...)
+}
+
+func (p *narrowPayload[T]) Var() Payload {
+	variance, na := genVar(p.data, p.na)
+
+	return FloatPayload([]float64{variance}, []bool{na})
+}
+
+func (p *narrowPayload[T]) Sd() Payload {
+	sd, na := genSd(p.data, p.na)
+
+	return FloatPayload([]float64{sd}, []bool{na})
+}
+
+func (p *narrowPayload[T]) Quantile(probs []float64) Payload {
+	quantiles, na := genQuantile(p.data, p.na, probs)
+
+	return FloatPayload(quantiles, na)
+}
+
+func (p *narrowPayload[T]) IQR() Payload {
+	iqr, na := genIQR(p.data, p.na)
+
+	return FloatPayload([]float64{iqr}, []bool{na})
+}
+
+func (p *narrowPayload[T]) Mad() Payload {
+	mad, na := genMad(p.data, p.na)
+
+	return FloatPayload([]float64{mad}, []bool{na})
+}
+
+func (p *narrowPayload[T]) Skewness() Payload {
+	skewness, na := genSkewness(p.data, p.na)
+
+	return FloatPayload([]float64{skewness}, []bool{na})
+}
+
+func (p *narrowPayload[T]) Kurtosis() Payload {
+	kurtosis, na := genKurtosis(p.data, p.na)
+
+	return FloatPayload([]float64{kurtosis}, []bool{na})
+}
+
+// widened returns the payload converted to int for integer types and to float64 for float32.
+func (p *narrowPayload[T]) widened() Payload {
+	if isNarrowFloat[T]() {
+		data, na := p.Floats()
+		return FloatPayload(data, na, p.Options()...)
+	}
+
+	data, na := p.Integers()
+	return IntegerPayload(data, na)
+}
@@ -0,0 +1,377 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNarrow(t *testing.T) {
+	testData := []struct {
+		name    string
+		vec     Vector
+		outType string
+		outData any
+		outNA   []bool
+		isEmpty bool
+	}{
+		{
+			name:    "int32",
+			vec:     Int32WithNA([]int32{1, -2, 3}, []bool{false, false, true}),
+			outType: PayloadTypeInt32,
+			outData: []int32{1, -2, 0},
+			outNA:   []bool{false, false, true},
+		},
+		{
+			name:    "int64",
+			vec:     Int64([]int64{math.MaxInt64, 0}),
+			outType: PayloadTypeInt64,
+			outData: []int64{math.MaxInt64, 0},
+			outNA:   []bool{false, false},
+		},
+		{
+			name:    "uint8",
+			vec:     Uint8([]uint8{0, 255}),
+			outType: PayloadTypeUint8,
+			outData: []uint8{0, 255},
+			outNA:   []bool{false, false},
+		},
+		{
+			name:    "float32",
+			vec:     Float32WithNA([]float32{1.5, 2}, []bool{true, false}),
+			outType: PayloadTypeFloat32,
+			outData: []float32{0, 2},
+			outNA:   []bool{true, false},
+		},
+		{
+			name:    "incorrect sized na",
+			vec:     Int32WithNA([]int32{1, 2}, []bool{false}),
+			isEmpty: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if data.isEmpty {
+				if !data.vec.IsEmpty() {
+					t.Error("Vector is not empty")
+				}
+				return
+			}
+
+			if data.vec.Type() != data.outType {
+				t.Error(fmt.Sprintf("Type (%v) is not equal to expected (%v)", data.vec.Type(), data.outType))
+			}
+
+			var outData any
+			switch payload := data.vec.(*vector).Payload().(type) {
+			case *narrowPayload[int32]:
+				outData = payload.data
+			case *narrowPayload[int64]:
+				outData = payload.data
+			case *narrowPayload[uint8]:
+				outData = payload.data
+			case *narrowPayload[float32]:
+				outData = payload.data
+			}
+
+			if !reflect.DeepEqual(outData, data.outData) {
+				t.Error(fmt.Sprintf("Data (%v) are not equal to expected (%v)", outData, data.outData))
+			}
+			if !reflect.DeepEqual(data.vec.IsNA(), data.outNA) {
+				t.Error(fmt.Sprintf("NA (%v) are not equal to expected (%v)", data.vec.IsNA(), data.outNA))
+			}
+		})
+	}
+}
+
+func TestNarrowPayload_Conversions(t *testing.T) {
+	vec := Uint8WithNA([]uint8{0, 200, 1}, []bool{false, false, true})
+
+	integers, na := vec.Integers()
+	if !reflect.DeepEqual(integers, []int{0, 200, 0}) || !reflect.DeepEqual(na, []bool{false, false, true}) {
+		t.Error(fmt.Sprintf("Integers (%v, %v) are not equal to expected", integers, na))
+	}
+
+	strings, _ := vec.Strings()
+	if !reflect.DeepEqual(strings, []string{"0", "200", ""}) {
+		t.Error(fmt.Sprintf("Strings (%v) are not equal to expected", strings))
+	}
+
+	booleans, _ := vec.Booleans()
+	if !reflect.DeepEqual(booleans, []bool{false, true, false}) {
+		t.Error(fmt.Sprintf("Booleans (%v) are not equal to expected", booleans))
+	}
+
+	floatVec := Float32([]float32{1.25, float32(math.Inf(1))}, OptionPrecision(1))
+	if floatVec.StrForElem(1) != "1.2" || floatVec.StrForElem(2) != "+Inf" {
+		t.Error(fmt.Sprintf("StrForElem() (%v, %v) is not equal to expected", floatVec.StrForElem(1),
+			floatVec.StrForElem(2)))
+	}
+
+	integers, na = floatVec.Integers()
+	if !reflect.DeepEqual(integers, []int{1, 0}) || !reflect.DeepEqual(na, []bool{false, true}) {
+		t.Error(fmt.Sprintf("Float32 integers (%v, %v) are not equal to expected", integers, na))
+	}
+}
+
+func TestVector_AsNarrow(t *testing.T) {
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "int32 from integer",
+			out:  IntegerWithNA([]int{1, 2}, []bool{false, true}).AsInt32(),
+			exp:  Int32WithNA([]int32{1, 0}, []bool{false, true}),
+		},
+		{
+			name: "int64 from string",
+			out:  String([]string{"9000000000", "bad"}).AsInt64(),
+			exp:  Int64WithNA([]int64{9000000000, 0}, []bool{false, true}),
+		},
+		{
+			name: "uint8 from int32",
+			out:  Int32([]int32{7, 300}).AsUint8(),
+			exp:  Uint8WithNA([]uint8{7, 0}, []bool{false, true}),
+		},
+		{
+			name: "int32 boundaries",
+			out:  Integer([]int{math.MinInt32, math.MaxInt32, math.MinInt32 - 1, math.MaxInt32 + 1, 1 << 40}).AsInt32(),
+			exp: Int32WithNA([]int32{math.MinInt32, math.MaxInt32, 0, 0, 0},
+				[]bool{false, false, true, true, true}),
+		},
+		{
+			name: "int32 from int64 boundaries",
+			out:  Int64([]int64{math.MaxInt32, math.MaxInt32 + 1}).AsInt32(),
+			exp:  Int32WithNA([]int32{math.MaxInt32, 0}, []bool{false, true}),
+		},
+		{
+			name: "uint8 boundaries",
+			out:  Integer([]int{0, 255, -1, 256}).AsUint8(),
+			exp:  Uint8WithNA([]uint8{0, 255, 0, 0}, []bool{false, false, true, true}),
+		},
+		{
+			name: "int64 boundaries",
+			out:  Integer([]int{math.MinInt64, math.MaxInt64}).AsInt64(),
+			exp:  Int64([]int64{math.MinInt64, math.MaxInt64}),
+		},
+		{
+			name: "float32 from float",
+			out:  Float([]float64{0.5}).AsFloat32(),
+			exp:  Float32([]float32{0.5}),
+		},
+		{
+			name: "integer from int32",
+			out:  Int32([]int32{5}).AsInteger(),
+			exp:  Integer([]int{5}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestNarrowPayload_Compare(t *testing.T) {
+	vec := Uint8WithNA([]uint8{1, 10, 200, 0}, []bool{false, false, false, true})
+
+	testData := []struct {
+		name string
+		out  []bool
+		exp  []bool
+	}{
+		{name: "eq", out: vec.Eq(10), exp: []bool{false, true, false, false}},
+		{name: "eq float", out: vec.Eq(10.0), exp: []bool{false, true, false, false}},
+		{name: "eq out of range", out: vec.Eq(266), exp: []bool{false, false, false, false}},
+		{name: "neq", out: vec.Neq(int64(1)), exp: []bool{false, true, true, true}},
+		{name: "gt", out: vec.Gt(10), exp: []bool{false, false, true, false}},
+		{name: "lt", out: vec.Lt(10), exp: []bool{true, false, false, false}},
+		{name: "gte", out: vec.Gte(10), exp: []bool{false, true, true, false}},
+		{name: "lte", out: vec.Lte(10), exp: []bool{true, true, false, false}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestNarrowPayload_Arithmetics(t *testing.T) {
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "int32 + int32",
+			out:  Int32WithNA([]int32{1, 2}, []bool{false, true}).Add(Int32([]int32{10})),
+			exp:  Int32WithNA([]int32{11, 0}, []bool{false, true}),
+		},
+		{
+			name: "uint8 + int32",
+			out:  Uint8([]uint8{250}).Add(Int32([]int32{10})),
+			exp:  Int32([]int32{260}),
+		},
+		{
+			name: "uint8 overflow",
+			out:  Uint8([]uint8{250}).Add(Uint8([]uint8{10})),
+			exp:  Uint8([]uint8{4}),
+		},
+		{
+			name: "int32 - int64",
+			out:  Int32([]int32{5}).Sub(Int64([]int64{1 << 40})),
+			exp:  Int64([]int64{5 - 1<<40}),
+		},
+		{
+			name: "int64 * integer",
+			out:  Int64([]int64{3}).Mul(Integer([]int{4})),
+			exp:  Integer([]int{12}),
+		},
+		{
+			name: "int32 / int32",
+			out:  Int32([]int32{7, 7}).Div(Int32([]int32{2, 0})),
+			exp:  Int32WithNA([]int32{3, 0}, []bool{false, true}),
+		},
+		{
+			name: "int32 / float32",
+			out:  Int32([]int32{7}).Div(Float32([]float32{2})),
+			exp:  Float([]float64{3.5}),
+		},
+		{
+			name: "uint8 / float32",
+			out:  Uint8([]uint8{7}).Div(Float32([]float32{2})),
+			exp:  Float32([]float32{3.5}),
+		},
+		{
+			name: "int64 + float32",
+			out:  Int64([]int64{16777217}).Add(Float32([]float32{0})),
+			exp:  Float([]float64{16777217}),
+		},
+		{
+			name: "float32 + float",
+			out:  Float32([]float32{0.5}).Add(Float([]float64{0.25})),
+			exp:  Float([]float64{0.75}),
+		},
+		{
+			name: "float32 * complex",
+			out:  Float32([]float32{2}).Mul(Complex([]complex128{1 + 1i})),
+			exp:  Complex([]complex128{2 + 2i}),
+		},
+		{
+			name: "string",
+			out:  Int32([]int32{1}).Add(String([]string{"2"})),
+			exp:  Int32([]int32{3}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
+
+func TestNarrowPayload_ArithmeticsCommutativity(t *testing.T) {
+	vectors := []Vector{
+		Uint8([]uint8{200, 3}),
+		Int32([]int32{16777217, -2}),
+		Int64([]int64{1 << 40, 5}),
+		Integer([]int{1, 7}),
+		Float32([]float32{0.5, 2}),
+		Float([]float64{0.25, 3}),
+		Complex([]complex128{1 + 1i, 2}),
+	}
+
+	// Operations of integer, float and complex payloads keep the type of the first operand.
+	wide := map[string]bool{PayloadTypeInteger: true, PayloadTypeFloat: true, PayloadTypeComplex: true}
+
+	for _, vec1 := range vectors {
+		for _, vec2 := range vectors {
+			if wide[vec1.Type()] && wide[vec2.Type()] {
+				continue
+			}
+
+			t.Run(vec1.Type()+" and "+vec2.Type(), func(t *testing.T) {
+				sum1 := vec1.Add(vec2)
+				sum2 := vec2.Add(vec1)
+				if !CompareVectorsForTest(sum1, sum2) {
+					t.Error(fmt.Sprintf("Sums (%v and %v) are not equal", sum1, sum2))
+				}
+
+				product1 := vec1.Mul(vec2)
+				product2 := vec2.Mul(vec1)
+				if !CompareVectorsForTest(product1, product2) {
+					t.Error(fmt.Sprintf("Products (%v and %v) are not equal", product1, product2))
+				}
+
+				expType := promotedType(vec1.Type(), vec2.Type())
+				if sum1.Type() != expType {
+					t.Error(fmt.Sprintf("Type (%v) is not equal to expected (%v)", sum1.Type(), expType))
+				}
+			})
+		}
+	}
+}
+
+func TestNarrowPayload_Statistics(t *testing.T) {
+	vec := Int32([]int32{4, 1, 3, 2})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{name: "sum", out: vec.Sum(), exp: Integer([]int{10})},
+		{name: "prod", out: vec.Prod(), exp: Integer([]int{24})},
+		{name: "max", out: vec.Max(), exp: Int32([]int32{4})},
+		{name: "min", out: vec.Min(), exp: Int32([]int32{1})},
+		{name: "mean", out: vec.Mean(), exp: Float([]float64{2.5})},
+		{name: "median", out: vec.Median(), exp: Float([]float64{2.5})},
+		{name: "cumsum", out: vec.CumSum(), exp: Integer([]int{4, 5, 8, 10})},
+		{name: "uint8 sum overflow", out: Uint8([]uint8{200, 100}).Sum(), exp: Integer([]int{300})},
+		{name: "uint8 median overflow", out: Uint8([]uint8{200, 100}).Median(), exp: Float([]float64{150})},
+		{name: "uint8 cumsum overflow", out: Uint8([]uint8{200, 100}).CumSum(), exp: Integer([]int{200, 300})},
+		{
+			name: "int32 sum overflow",
+			out:  Int32([]int32{math.MaxInt32, 1}).Sum(),
+			exp:  Integer([]int{math.MaxInt32 + 1}),
+		},
+		{
+			name: "int32 median overflow",
+			out:  Int32([]int32{math.MaxInt32, math.MaxInt32 - 1}).Median(),
+			exp:  Float([]float64{math.MaxInt32 - 0.5}),
+		},
+		{
+			name: "int32 prod overflow",
+			out:  Int32([]int32{1 << 20, 1 << 20}).Prod(),
+			exp:  Integer([]int{1 << 40}),
+		},
+		{name: "float32 sum", out: Float32([]float32{0.5, 1}).Sum(), exp: Float([]float64{1.5})},
+		{name: "cummax", out: vec.CumMax(), exp: Int32([]int32{4, 4, 4, 4})},
+		{
+			name: "max with na",
+			out:  Float32WithNA([]float32{0, 1}, []bool{true, false}).Max(),
+			exp:  Float32WithNA([]float32{0}, []bool{true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ type stringPayload struct {
 	DefArrangeable
 	StringToBooleanConverter
 	timeFormat string
+	dateFormat string
 }
 
 func (p *stringPayload) Type() string {
@@ -44,6 +45,29 @@ func (p *stringPayload) ByIndices(indices []int) Payload {
 	return StringPayload(data, na, p.Options()...)
 }
 
+func (p *stringPayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *stringPayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i] < p.data[j]
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i] == p.data[j]
+		},
+	}
+}
+
 func (p *stringPayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[string](whicher)
 }
@@ -87,7 +111,7 @@ func (p *stringPayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -95,7 +119,7 @@ func (p *stringPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -107,10 +131,10 @@ func (p *stringPayload) Integers() ([]int, []bool) {
 
 	data := make([]int, p.length)
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = 0
 		} else {
 			num, err := strconv.Atoi(p.data[i])
@@ -133,10 +157,10 @@ func (p *stringPayload) Floats() ([]float64, []bool) {
 
 	data := make([]float64, p.length)
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = math.NaN()
 		} else {
 			num, err := strconv.ParseFloat(p.data[i], 64)
@@ -159,10 +183,10 @@ func (p *stringPayload) Times() ([]time.Time, []bool) {
 
 	data := make([]time.Time, p.length)
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			continue
 		}
 		date, err := time.Parse(p.timeFormat, p.data[i])
@@ -176,6 +200,54 @@ func (p *stringPayload) Times() ([]time.Time, []bool) {
 	return data, na
 }
 
+func (p *stringPayload) Dates() ([]time.Time, []bool) {
+	if p.length == 0 {
+		return []time.Time{}, []bool{}
+	}
+
+	data := make([]time.Time, p.length)
+	na := make([]bool, p.Len())
+	p.na.copyTo(na)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) {
+			continue
+		}
+		date, err := time.Parse(p.dateFormat, p.data[i])
+		if err == nil {
+			data[i] = date
+		} else {
+			na[i] = true
+		}
+	}
+
+	return data, na
+}
+
+func (p *stringPayload) Durations() ([]time.Duration, []bool) {
+	if p.length == 0 {
+		return []time.Duration{}, []bool{}
+	}
+
+	data := make([]time.Duration, p.length)
+	na := make([]bool, p.Len())
+	p.na.copyTo(na)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) {
+			continue
+		}
+		duration, err := time.ParseDuration(p.data[i])
+		if err == nil {
+			data[i] = duration
+		} else {
+			na[i] = true
+		}
+	}
+
+	return data, na
+}
+
 func (p *stringPayload) Complexes() ([]complex128, []bool) {
 	if p.length == 0 {
 		return []complex128{}, []bool{}
@@ -183,10 +255,10 @@ func (p *stringPayload) Complexes() ([]complex128, []bool) {
 
 	data := make([]complex128, p.length)
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = cmplx.NaN()
 		} else {
 			num, err := strconv.ParseComplex(p.data[i], 128)
@@ -209,13 +281,13 @@ func (p *stringPayload) Booleans() ([]bool, []bool) {
 
 	data := make([]bool, p.length)
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	trueValues := p.TrueValues()
 	falseValues := p.FalseValues()
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = false
 		} else {
 			if slices.Contains(trueValues, p.data[i]) {
@@ -241,7 +313,7 @@ func (p *stringPayload) Strings() ([]string, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -263,7 +335,7 @@ func (p *stringPayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return StringPayload(newVals, newNA, p.Options()...)
@@ -277,7 +349,7 @@ func (p *stringPayload) Groups() ([][]int, []any) {
 	for i, val := range p.data {
 		idx := i + 1
 
-		if p.na[i] {
+		if p.na.at(i) {
 			na = append(na, idx)
 			continue
 		}
@@ -311,7 +383,7 @@ func (p *stringPayload) Groups() ([][]int, []any) {
 }
 
 func (p *stringPayload) StrForElem(idx int) string {
-	if p.na[idx-1] {
+	if p.na.at(idx - 1) {
 		return "NA"
 	}
 
@@ -335,7 +407,7 @@ func (p *stringPayload) adjustToLesserSize(size int) Payload {
 	na := make([]bool, size)
 
 	copy(data, p.data)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return StringPayload(data, na, p.Options()...)
 }
@@ -351,7 +423,7 @@ func (p *stringPayload) adjustToBiggerSize(size int) Payload {
 
 	for i := 0; i < cycles; i++ {
 		copy(data[i*p.length:], p.data)
-		copy(na[i*p.length:], p.na)
+		p.na.copyTo(na[i*p.length : (i+1)*p.length])
 	}
 
 	data = data[:size]
@@ -415,7 +487,7 @@ func (p *stringPayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -443,7 +515,7 @@ func (p *stringPayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*stringPayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if stringable, ok := payload.(Stringable); ok {
 		srcData, srcNA = stringable.Strings()
 	} else {
@@ -454,12 +526,12 @@ func (p *stringPayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -470,6 +542,7 @@ func (p *stringPayload) Options() []Option {
 	return []Option{
 		ConfOption{keyOptionStringToBooleanConverter, p.StringToBooleanConverter},
 		ConfOption{keyOptionTimeFormat, p.timeFormat},
+		ConfOption{keyOptionDateFormat, p.dateFormat},
 	}
 }
 
@@ -479,6 +552,8 @@ func (p *stringPayload) SetOption(name string, val any) bool {
 		p.StringToBooleanConverter = val.(StringToBooleanConverter)
 	case keyOptionTimeFormat:
 		p.timeFormat = val.(string)
+	case keyOptionDateFormat:
+		p.dateFormat = val.(string)
 	default:
 		return false
 	}
@@ -492,6 +567,7 @@ func (p *stringPayload) SetOption(name string, val any) bool {
 //   - OptionStringToBooleanConverter(converter StringToBooleanConverter) - sets a converter
 //     from string to boolean values.
 //   - OptionTimeFormat(format string) - sets a time format for conversion to time.
+//   - OptionDateFormat(format string) - sets a date format for conversion to date.
 func StringPayload(data []string, na []bool, options ...Option) Payload {
 	length := len(data)
 	conf := MergeOptions(options)
@@ -516,24 +592,14 @@ func StringPayload(data []string, na []bool, options ...Option) Payload {
 	}
 
 	payload := &stringPayload{
-		length: length,
-		data:   vecData,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:     length,
+		data:       vecData,
+		DefNAble:   newDefNAble(vecNA),
 		timeFormat: time.RFC3339,
+		dateFormat: DefaultDateFormat,
 	}
 
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess: func(i, j int) bool {
-			return payload.data[i] < payload.data[j]
-		},
-		FnEqual: func(i, j int) bool {
-			return payload.data[i] == payload.data[j]
-		},
-	}
+	payload.initArrangeable()
 
 	conf.SetOptions(payload)
 	if payload.StringToBooleanConverter == nil {
@@ -63,7 +63,7 @@ func TestString(t *testing.T) {
 			vv := v.(*vector)
 
 			if data.isEmpty {
-				naPayload, ok := vv.payload.(*naPayload)
+				naPayload, ok := vv.Payload().(*naPayload)
 				if !ok || naPayload.Len() > 0 {
 					t.Error("Vector's payload is not empty")
 				}
@@ -73,7 +73,7 @@ func TestString(t *testing.T) {
 					t.Error(fmt.Sprintf("Vector length (%d) is not equal to data length (%d)\n", vv.length, length))
 				}
 
-				payload, ok := vv.payload.(*stringPayload)
+				payload, ok := vv.Payload().(*stringPayload)
 				if !ok {
 					t.Error("Payload is not stringPayload")
 				} else {
@@ -84,13 +84,13 @@ func TestString(t *testing.T) {
 				}
 
 				if len(data.na) > 0 && len(data.na) == length {
-					if !reflect.DeepEqual(payload.na, data.na) {
+					if !reflect.DeepEqual(payload.IsNA(), data.na) {
 						t.Error(fmt.Sprintf("Payload na (%v) is not equal to correct na (%v)\n",
-							payload.na, data.na))
+							payload.IsNA(), data.na))
 					}
 				} else if len(data.na) == 0 {
-					if !reflect.DeepEqual(payload.na, emptyNA) {
-						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.na (%v)", payload.na))
+					if !reflect.DeepEqual(payload.IsNA(), emptyNA) {
+						t.Error(fmt.Sprintf("len(data.na) == 0 : incorrect payload.IsNA() (%v)", payload.IsNA()))
 					}
 				} else {
 					t.Error("error")
@@ -120,7 +120,7 @@ func TestStringPayload_Len(t *testing.T) {
 
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			payload := StringWithNA(data.in, nil).(*vector).payload
+			payload := StringWithNA(data.in, nil).(*vector).Payload()
 			if payload.Len() != data.outLength {
 				t.Error(fmt.Sprintf("Payloads's length (%d) is not equal to out (%d)",
 					payload.Len(), data.outLength))
@@ -147,7 +147,7 @@ func TestStringPayload_Booleans(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			booleans, na := payload.Booleans()
 			if !reflect.DeepEqual(booleans, data.out) {
@@ -190,7 +190,7 @@ func TestStringPayload_Integers(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			integers, na := payload.Integers()
 			if !reflect.DeepEqual(integers, data.out) {
@@ -233,7 +233,7 @@ func TestStringPayload_Floats(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			floats, na := payload.Floats()
 			correct := true
@@ -280,7 +280,7 @@ func TestStringPayload_Complexes(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			complexes, na := payload.Complexes()
 			correct := true
@@ -333,7 +333,7 @@ func TestStringPayload_Strings(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			strings, na := payload.Strings()
 			if !reflect.DeepEqual(strings, data.out) {
@@ -376,7 +376,7 @@ func TestStringPayload_Anies(t *testing.T) {
 	for i, data := range testData {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			vec := StringWithNA(data.in, data.inNA)
-			payload := vec.(*vector).payload.(*stringPayload)
+			payload := vec.(*vector).Payload().(*stringPayload)
 
 			anies, na := payload.Anies()
 			if !reflect.DeepEqual(anies, data.out) {
@@ -462,11 +462,11 @@ func TestStringPayload_ByIndices(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := vec.ByIndices(data.indices).(*vector).payload.(*stringPayload)
+			payload := vec.ByIndices(data.indices).(*vector).Payload().(*stringPayload)
 			if !reflect.DeepEqual(payload.data, data.out) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("payload.data (%v) is not equal to data.out (%v)", payload.data, data.out))
 			}
 		})
@@ -501,7 +501,7 @@ func TestStringPayload_SupportsWhicher(t *testing.T) {
 		},
 	}
 
-	payload := StringWithNA([]string{"one"}, nil).(*vector).payload.(Whichable)
+	payload := StringWithNA([]string{"one"}, nil).(*vector).Payload().(Whichable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsWhicher(data.filter) != data.isSupported {
@@ -544,7 +544,7 @@ func TestStringPayload_Whicher(t *testing.T) {
 		},
 	}
 
-	payload := StringWithNA([]string{"1", "2", "39", "4", "56", "2", "45", "90", "4", "3"}, nil).(*vector).payload.(Whichable)
+	payload := StringWithNA([]string{"1", "2", "39", "4", "56", "2", "45", "90", "4", "3"}, nil).(*vector).Payload().(Whichable)
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
@@ -626,7 +626,7 @@ func TestStringPayload_Apply(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := StringWithNA(data.dataIn, data.naIn).(*vector).payload.(Appliable).Apply(data.applier)
+			payload := StringWithNA(data.dataIn, data.naIn).(*vector).Payload().(Appliable).Apply(data.applier)
 
 			if !data.isNAPayload {
 				payloadOut := payload.(*stringPayload)
@@ -634,9 +634,9 @@ func TestStringPayload_Apply(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						payloadOut.data, data.dataOut))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						payloadOut.na, data.naOut))
+						payloadOut.IsNA(), data.naOut))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
@@ -666,7 +666,7 @@ func TestStringPayload_SupportsSummarizer(t *testing.T) {
 		},
 	}
 
-	payload := StringWithNA([]string{}, nil).(*vector).payload.(Summarizable)
+	payload := StringWithNA([]string{}, nil).(*vector).Payload().(Summarizable)
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			if payload.SupportsSummarizer(data.summarizer) != data.isSupported {
@@ -717,16 +717,16 @@ func TestStringPayload_Summarize(t *testing.T) {
 
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
-			payload := StringWithNA(data.dataIn, data.naIn).(*vector).payload.(Summarizable).Summarize(data.summarizer)
+			payload := StringWithNA(data.dataIn, data.naIn).(*vector).Payload().(Summarizable).Summarize(data.summarizer)
 
 			payloadOut := payload.(*stringPayload)
 			if !reflect.DeepEqual(data.dataOut, payloadOut.data) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					data.dataOut, payloadOut.data))
 			}
-			if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+			if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					data.naOut, payloadOut.na))
+					data.naOut, payloadOut.IsNA()))
 			}
 		})
 	}
@@ -769,9 +769,9 @@ func TestStringPayload_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -816,9 +816,9 @@ func TestStringPayload_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outPaylout.data))
 			}
-			if !reflect.DeepEqual(outPayload.na, data.outPaylout.na) {
+			if !reflect.DeepEqual(outPayload.IsNA(), data.outPaylout.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outPaylout.na))
+					outPayload.IsNA(), data.outPaylout.IsNA()))
 			}
 		})
 	}
@@ -1142,9 +1142,9 @@ func TestStringPayload_Coalesce(t *testing.T) {
 					payload.data, data.outData))
 			}
 
-			if !reflect.DeepEqual(payload.na, data.outNA) {
+			if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
 				t.Error(fmt.Sprintf("NA (%v) do not match expected (%v)",
-					payload.na, data.outNA))
+					payload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1289,9 +1289,9 @@ func TestStringPayload_ApplyTo(t *testing.T) {
 					t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 						data.dataOut, payloadOut.data))
 				}
-				if !reflect.DeepEqual(data.naOut, payloadOut.na) {
+				if !reflect.DeepEqual(data.naOut, payloadOut.IsNA()) {
 					t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-						data.naOut, payloadOut.na))
+						data.naOut, payloadOut.IsNA()))
 				}
 			} else {
 				_, ok := payload.(*naPayload)
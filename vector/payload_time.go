@@ -38,6 +38,29 @@ func (p *timePayload) ByIndices(indices []int) Payload {
 	return TimePayload(data, na, p.Options()...)
 }
 
+func (p *timePayload) slice(from, to int) Payload {
+	payload := *p
+	payload.length = to - from
+	payload.data = p.data[from:to]
+	payload.DefNAble = p.DefNAble.slice(from, to)
+	payload.initArrangeable()
+
+	return &payload
+}
+
+func (p *timePayload) initArrangeable() {
+	p.DefArrangeable = DefArrangeable{
+		Length:   p.length,
+		DefNAble: p.DefNAble,
+		FnLess: func(i, j int) bool {
+			return p.data[i].Before(p.data[j])
+		},
+		FnEqual: func(i, j int) bool {
+			return p.data[i].Equal(p.data[j])
+		},
+	}
+}
+
 func (p *timePayload) SupportsWhicher(whicher any) bool {
 	return supportsWhicherWithNA[time.Time](whicher)
 }
@@ -82,7 +105,7 @@ func (p *timePayload) Strings() ([]string, []bool) {
 	data := make([]string, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = ""
 		} else {
 			data[i] = p.StrForElem(i + 1)
@@ -90,7 +113,7 @@ func (p *timePayload) Strings() ([]string, []bool) {
 	}
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -104,7 +127,26 @@ func (p *timePayload) Times() ([]time.Time, []bool) {
 	copy(data, p.data)
 
 	na := make([]bool, p.Len())
-	copy(na, p.na)
+	p.na.copyTo(na)
+
+	return data, na
+}
+
+// Dates returns calendar dates of the times in their locations.
+func (p *timePayload) Dates() ([]time.Time, []bool) {
+	if p.length == 0 {
+		return []time.Time{}, []bool{}
+	}
+
+	data := make([]time.Time, p.length)
+	for i, val := range p.data {
+		if !p.na.at(i) {
+			data[i] = daysToTime(timeToDays(val))
+		}
+	}
+
+	na := make([]bool, p.Len())
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -116,7 +158,7 @@ func (p *timePayload) Anies() ([]any, []bool) {
 
 	data := make([]any, p.length)
 	for i := 0; i < p.length; i++ {
-		if p.na[i] {
+		if p.na.at(i) {
 			data[i] = nil
 		} else {
 			data[i] = p.data[i]
@@ -124,7 +166,7 @@ func (p *timePayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -146,7 +188,7 @@ func (p *timePayload) Append(payload Payload) Payload {
 
 	copy(newVals, p.data)
 	copy(newVals[p.length:], vals)
-	copy(newNA, p.na)
+	p.na.copyTo(newNA)
 	copy(newNA[p.length:], na)
 
 	return TimePayload(newVals, newNA)
@@ -244,7 +286,7 @@ func (p *timePayload) IsUnique() []bool {
 	for i := 0; i < p.length; i++ {
 		is := false
 
-		if p.na[i] {
+		if p.na.at(i) {
 			if !wasNA {
 				is = true
 				wasNA = true
@@ -291,7 +333,7 @@ func (p *timePayload) Coalesce(payload Payload) Payload {
 
 	if same, ok := payload.(*timePayload); ok {
 		srcData = same.data
-		srcNA = same.na
+		srcNA = same.IsNA()
 	} else if timeable, ok := payload.(Timeable); ok {
 		srcData, srcNA = timeable.Times()
 	} else {
@@ -302,12 +344,12 @@ func (p *timePayload) Coalesce(payload Payload) Payload {
 	dstNA := make([]bool, p.length)
 
 	for i := 0; i < p.length; i++ {
-		if p.na[i] && !srcNA[i] {
+		if p.na.at(i) && !srcNA[i] {
 			dstData[i] = srcData[i]
 			dstNA[i] = false
 		} else {
 			dstData[i] = p.data[i]
-			dstNA[i] = p.na[i]
+			dstNA[i] = p.na.at(i)
 		}
 	}
 
@@ -344,26 +386,15 @@ func TimePayload(data []time.Time, na []bool, options ...Option) Payload {
 	printer := TimePrinter{Format: time.RFC3339}
 
 	payload := &timePayload{
-		length:  length,
-		data:    vecData,
-		printer: printer,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		printer:  printer,
+		DefNAble: newDefNAble(vecNA),
 	}
 
 	conf.SetOptions(payload)
 
-	payload.DefArrangeable = DefArrangeable{
-		Length:   payload.length,
-		DefNAble: payload.DefNAble,
-		FnLess: func(i, j int) bool {
-			return payload.data[i].Before(payload.data[j])
-		},
-		FnEqual: func(i, j int) bool {
-			return payload.data[i].Equal(payload.data[j])
-		},
-	}
+	payload.initArrangeable()
 
 	return payload
 }
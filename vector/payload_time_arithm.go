@@ -0,0 +1,76 @@
+package vector
+
+import (
+	"time"
+)
+
+// Add adds durations to the times.
+func (p *timePayload) Add(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	durationable, ok := p2.(Durationable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	addDurations, addNA := durationable.Durations()
+
+	times := make([]time.Time, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || addNA[i] {
+			na[i] = true
+		} else {
+			times[i] = p.data[i].Add(addDurations[i])
+		}
+	}
+
+	return TimePayload(times, na, p.Options()...)
+}
+
+// Sub returns durations between the times and times (or dates) of another payload. If another payload contains
+// durations, they are subtracted from the times.
+func (p *timePayload) Sub(p2 Payload) Payload {
+	if p.length != p2.Len() {
+		p2 = p2.Adjust(p.length)
+	}
+
+	switch p2.(type) {
+	case *timePayload, *datePayload:
+		subTimes, subNA := p2.(Timeable).Times()
+
+		durations := make([]time.Duration, p.length)
+		na := make([]bool, p.length)
+
+		for i := 0; i < p.length; i++ {
+			if p.na.at(i) || subNA[i] {
+				na[i] = true
+			} else {
+				durations[i] = p.data[i].Sub(subTimes[i])
+			}
+		}
+
+		return DurationPayload(durations, na)
+	}
+
+	durationable, ok := p2.(Durationable)
+	if !ok {
+		return NAPayload(p.length)
+	}
+	subDurations, subNA := durationable.Durations()
+
+	times := make([]time.Time, p.length)
+	na := make([]bool, p.length)
+
+	for i := 0; i < p.length; i++ {
+		if p.na.at(i) || subNA[i] {
+			na[i] = true
+		} else {
+			times[i] = p.data[i].Add(-subDurations[i])
+		}
+	}
+
+	return TimePayload(times, na, p.Options()...)
+}
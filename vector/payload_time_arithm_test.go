@@ -0,0 +1,54 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTimePayload_Arithmetics(t *testing.T) {
+	vec := TimeWithNA([]time.Time{
+		time.Date(2022, 3, 15, 10, 0, 0, 0, time.UTC),
+		{},
+	}, []bool{false, true})
+
+	testData := []struct {
+		name string
+		out  Vector
+		exp  Vector
+	}{
+		{
+			name: "time + duration",
+			out:  vec.Add(Duration([]time.Duration{90 * time.Minute})),
+			exp:  TimeWithNA([]time.Time{time.Date(2022, 3, 15, 11, 30, 0, 0, time.UTC), {}}, []bool{false, true}),
+		},
+		{
+			name: "time - duration",
+			out:  vec.Sub(Duration([]time.Duration{time.Hour})),
+			exp:  TimeWithNA([]time.Time{time.Date(2022, 3, 15, 9, 0, 0, 0, time.UTC), {}}, []bool{false, true}),
+		},
+		{
+			name: "time - time",
+			out:  vec.Sub(Time([]time.Time{time.Date(2022, 3, 14, 9, 30, 0, 0, time.UTC)})),
+			exp:  DurationWithNA([]time.Duration{24*time.Hour + 30*time.Minute, 0}, []bool{false, true}),
+		},
+		{
+			name: "time - date",
+			out:  vec.Sub(Date([]time.Time{time.Date(2022, 3, 15, 0, 0, 0, 0, time.UTC)})),
+			exp:  DurationWithNA([]time.Duration{10 * time.Hour, 0}, []bool{false, true}),
+		},
+		{
+			name: "time + boolean",
+			out:  vec.Add(Boolean([]bool{true})),
+			exp:  NA(2),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.out, data.exp) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.out, data.exp))
+			}
+		})
+	}
+}
@@ -111,7 +111,7 @@ func (p *vectorPayload) Anies() ([]any, []bool) {
 	}
 
 	na := make([]bool, p.length)
-	copy(na, p.na)
+	p.na.copyTo(na)
 
 	return data, na
 }
@@ -198,11 +198,9 @@ func VectorPayload(data []Vector, _ ...Option) Payload {
 	copy(vecData, data)
 
 	return &vectorPayload{
-		length: length,
-		data:   vecData,
-		DefNAble: DefNAble{
-			na: vecNA,
-		},
+		length:   length,
+		data:     vecData,
+		DefNAble: newDefNAble(vecNA),
 	}
 }
 
@@ -49,6 +49,8 @@ type Vector interface {
 	Stringable
 	Complexable
 	Timeable
+	Dateable
+	Durationable
 	Anyable
 	AsInteger(options ...Option) Vector
 	AsFloat(options ...Option) Vector
@@ -56,10 +58,27 @@ type Vector interface {
 	AsBoolean(options ...Option) Vector
 	AsString(options ...Option) Vector
 	AsTime(options ...Option) Vector
+	AsDate(options ...Option) Vector
+	AsDuration(options ...Option) Vector
+	AsDecimal(options ...Option) Vector
+	AsInt32(options ...Option) Vector
+	AsInt64(options ...Option) Vector
+	AsUint8(options ...Option) Vector
+	AsFloat32(options ...Option) Vector
 	AsAny(options ...Option) Vector
+	AsFactor(options ...Option) Vector
+	AsOrderedFactor(options ...Option) Vector
+
+	Levels() []string
+	IsOrdered() bool
+	Relevel(levels ...string) Vector
+	DropLevels() Vector
 
 	Finder
 	Has(any) bool
+	IsIn(Vector) []bool
+	// Equalable and Ordered methods of a vector also accept another vector, which is compared element-wise
+	// (see Equal and Greater). NA-results are false, except for Neq where they are true.
 	Equalable
 	Ordered
 	Arrangeable
@@ -67,6 +86,7 @@ type Vector interface {
 	Unique() Vector
 
 	Coalesce(...Vector) Vector
+	Recode(mapping map[any]any, def any) Vector
 
 	Options() []Option
 	SetOption(Option) bool
@@ -75,7 +95,11 @@ type Vector interface {
 	String() string
 
 	Arithmetics
+	Logical
+	Binning
+	NAFiller
 	Statistics
+	Window
 }
 
 // Payload is a minimally required interface which has to be implemented in order to make a new payload type.
@@ -177,6 +201,20 @@ type Timeable interface {
 	Times() ([]time.Time, []bool)
 }
 
+// Dateable interface has to be implemented to enable conversion of payload values to calendar dates.
+type Dateable interface {
+	// Dates returns an array of dates (as times at midnight UTC) and a corresponding array of boolean values where
+	// true indicates NA-value.
+	Dates() ([]time.Time, []bool)
+}
+
+// Durationable interface has to be implemented to enable conversion of payload values to durations.
+type Durationable interface {
+	// Durations returns an array of durations and a corresponding array of boolean values where true indicates
+	// NA-value.
+	Durations() ([]time.Duration, []bool)
+}
+
 // Anyable interface has to be implemented to enable conversion of payload values to anies.
 type Anyable interface {
 	// Anies returns an array of anies and a corresponding array of boolean values where true indicates NA-value.
@@ -250,6 +288,18 @@ type Coalescer interface {
 	Coalesce(Payload) Payload
 }
 
+// Categorical interface has to be implemented by a payload which stores values as codes of a level set.
+type Categorical interface {
+	// Levels returns the level set of the payload.
+	Levels() []string
+	// IsOrdered returns true if elements of the payload can be compared by the level order.
+	IsOrdered() bool
+	// Relevel returns a payload with the provided levels moved to the beginning of the level set.
+	Relevel(levels ...string) Payload
+	// DropLevels returns a payload without levels which are not used by any element.
+	DropLevels() Payload
+}
+
 type vectorOptions struct {
 	maxPrintElements int
 }
@@ -259,6 +309,7 @@ type vector struct {
 	name       string
 	length     int
 	payload    Payload
+	view       *payloadView
 	groupIndex GroupIndex
 	options    vectorOptions
 }
@@ -274,6 +325,10 @@ func (v *vector) SetName(name string) Vector {
 }
 
 func (v *vector) Type() string {
+	if v.view != nil {
+		return v.view.source.Type()
+	}
+
 	return v.payload.Type()
 }
 
@@ -282,12 +337,18 @@ func (v *vector) Len() int {
 	return v.length
 }
 
+// Payload returns the payload of the vector. For a vector selected from another one the payload is materialized
+// on the first call.
 func (v *vector) Payload() Payload {
+	if v.view != nil {
+		return v.view.materialize()
+	}
+
 	return v.payload
 }
 
 func (v *vector) Clone() Vector {
-	vec := New(v.payload, v.Options()...)
+	vec := New(v.Payload(), v.Options()...)
 	vec.(*vector).groupIndex = v.groupIndex
 
 	return vec
@@ -302,13 +363,35 @@ func (v *vector) ByIndices(indices []int) Vector {
 		}
 	}
 
-	newPayload := v.payload.ByIndices(selected)
+	if v.view != nil {
+		return v.withView(v.view.byIndices(selected))
+	}
 
-	return New(newPayload, v.Options()...)
+	return v.withView(newIndicesView(v.payload, selected))
 }
 
 func (v *vector) FromTo(from, to int) Vector {
-	return v.ByIndices(util2.FromTo(from, to, v.length))
+	start, end, ok := util2.FromToRange(from, to, v.length)
+	if !ok {
+		return v.ByIndices(util2.FromTo(from, to, v.length))
+	}
+
+	if v.view != nil {
+		return v.withView(v.view.fromTo(start-1, end))
+	}
+
+	return v.withView(newRangeView(v.payload, start-1, end))
+}
+
+// withView creates a vector which lazily selects elements of the vector according to the view. The new vector
+// keeps the options of the vector.
+func (v *vector) withView(view *payloadView) Vector {
+	return &vector{
+		name:    v.name,
+		length:  view.len(),
+		view:    view,
+		options: v.options,
+	}
 }
 
 func (v *vector) Filter(whicher any) Vector {
@@ -332,7 +415,7 @@ func (v *vector) Filter(whicher any) Vector {
 }
 
 func (v *vector) SupportsWhicher(whicher any) bool {
-	payload, ok := v.payload.(Whichable)
+	payload, ok := v.Payload().(Whichable)
 	if ok {
 		return payload.SupportsWhicher(whicher)
 	}
@@ -341,7 +424,7 @@ func (v *vector) SupportsWhicher(whicher any) bool {
 }
 
 func (v *vector) Which(whicher any) []bool {
-	payload, ok := v.payload.(Whichable)
+	payload, ok := v.Payload().(Whichable)
 	if ok && payload.SupportsWhicher(whicher) {
 		return payload.Which(whicher)
 	}
@@ -350,7 +433,7 @@ func (v *vector) Which(whicher any) []bool {
 }
 
 func (v *vector) Apply(applier any) Vector {
-	payload, ok := v.payload.(Appliable)
+	payload, ok := v.Payload().(Appliable)
 	if !ok {
 		return NA(v.Len())
 	}
@@ -361,7 +444,7 @@ func (v *vector) Apply(applier any) Vector {
 }
 
 func (v *vector) ApplyTo(whicher any, applier any) Vector {
-	payload, ok := v.payload.(AppliableTo)
+	payload, ok := v.Payload().(AppliableTo)
 	if !ok {
 		return NA(v.length)
 	}
@@ -407,7 +490,7 @@ func (v *vector) filterByBooleans(booleans []bool) []int {
 }
 
 func (v *vector) Traverse(traverser any) {
-	if payload, ok := v.payload.(Traversable); ok {
+	if payload, ok := v.Payload().(Traversable); ok {
 		payload.Traverse(traverser)
 	}
 }
@@ -426,21 +509,21 @@ func (v *vector) Append(vecs ...Vector) Vector {
 }
 
 func (v *vector) Adjust(size int) Vector {
-	newPayload := v.payload.Adjust(size)
+	newPayload := v.Payload().Adjust(size)
 
 	return New(newPayload, v.Options()...)
 }
 
 func (v *vector) Pick(idx int) any {
-	return v.payload.Pick(idx)
+	return v.Payload().Pick(idx)
 }
 
 func (v *vector) Data() []any {
-	return v.payload.Data()
+	return v.Payload().Data()
 }
 
 func (v *vector) Groups() ([][]int, []any) {
-	if groupper, ok := v.payload.(Grouper); ok {
+	if groupper, ok := v.Payload().(Grouper); ok {
 		return groupper.Groups()
 	}
 
@@ -456,7 +539,7 @@ func (v *vector) GroupByIndices(groups GroupIndex) Vector {
 		return v
 	}
 
-	newVec := New(v.payload, v.Options()...).(*vector)
+	newVec := New(v.Payload(), v.Options()...).(*vector)
 	newVec.groupIndex = groups
 
 	return newVec
@@ -494,13 +577,13 @@ func (v *vector) Ungroup() Vector {
 		return v
 	}
 
-	newVec := New(v.payload, v.Options()...).(*vector)
+	newVec := New(v.Payload(), v.Options()...).(*vector)
 
 	return newVec
 }
 
 func (v *vector) IsNA() []bool {
-	if nable, ok := v.payload.(NAble); ok {
+	if nable, ok := v.Payload().(NAble); ok {
 		return nable.IsNA()
 	}
 
@@ -508,7 +591,7 @@ func (v *vector) IsNA() []bool {
 }
 
 func (v *vector) NotNA() []bool {
-	if nable, ok := v.payload.(NAble); ok {
+	if nable, ok := v.Payload().(NAble); ok {
 		return nable.NotNA()
 	}
 
@@ -521,7 +604,7 @@ func (v *vector) NotNA() []bool {
 }
 
 func (v *vector) HasNA() bool {
-	if nable, ok := v.payload.(NAble); ok {
+	if nable, ok := v.Payload().(NAble); ok {
 		return nable.HasNA()
 	}
 
@@ -531,7 +614,7 @@ func (v *vector) HasNA() bool {
 /* Not Applicable-related */
 
 func (v *vector) WithNA() []int {
-	if nable, ok := v.payload.(NAble); ok {
+	if nable, ok := v.Payload().(NAble); ok {
 		return nable.WithNA()
 	}
 
@@ -539,7 +622,7 @@ func (v *vector) WithNA() []int {
 }
 
 func (v *vector) WithoutNA() []int {
-	if nable, ok := v.payload.(NAble); ok {
+	if nable, ok := v.Payload().(NAble); ok {
 		return nable.WithoutNA()
 	}
 
@@ -573,13 +656,13 @@ func (v *vector) String() string {
 }
 
 func (v *vector) StrForElem(idx int) string {
-	str := v.payload.StrForElem(idx)
+	str := v.Payload().StrForElem(idx)
 
 	return str
 }
 
 func (v *vector) Strings() ([]string, []bool) {
-	if payload, ok := v.payload.(Stringable); ok {
+	if payload, ok := v.Payload().(Stringable); ok {
 		return payload.Strings()
 	}
 
@@ -587,7 +670,7 @@ func (v *vector) Strings() ([]string, []bool) {
 }
 
 func (v *vector) Floats() ([]float64, []bool) {
-	if payload, ok := v.payload.(Floatable); ok {
+	if payload, ok := v.Payload().(Floatable); ok {
 		return payload.Floats()
 	}
 
@@ -595,7 +678,7 @@ func (v *vector) Floats() ([]float64, []bool) {
 }
 
 func (v *vector) Complexes() ([]complex128, []bool) {
-	if payload, ok := v.payload.(Complexable); ok {
+	if payload, ok := v.Payload().(Complexable); ok {
 		return payload.Complexes()
 	}
 
@@ -603,7 +686,7 @@ func (v *vector) Complexes() ([]complex128, []bool) {
 }
 
 func (v *vector) Booleans() ([]bool, []bool) {
-	if payload, ok := v.payload.(Boolable); ok {
+	if payload, ok := v.Payload().(Boolable); ok {
 		return payload.Booleans()
 	}
 
@@ -611,7 +694,7 @@ func (v *vector) Booleans() ([]bool, []bool) {
 }
 
 func (v *vector) Integers() ([]int, []bool) {
-	if payload, ok := v.payload.(Intable); ok {
+	if payload, ok := v.Payload().(Intable); ok {
 		return payload.Integers()
 	}
 
@@ -619,15 +702,31 @@ func (v *vector) Integers() ([]int, []bool) {
 }
 
 func (v *vector) Times() ([]time.Time, []bool) {
-	if payload, ok := v.payload.(Timeable); ok {
+	if payload, ok := v.Payload().(Timeable); ok {
 		return payload.Times()
 	}
 
 	return NA(v.length).Times()
 }
 
+func (v *vector) Dates() ([]time.Time, []bool) {
+	if payload, ok := v.Payload().(Dateable); ok {
+		return payload.Dates()
+	}
+
+	return NA(v.length).Dates()
+}
+
+func (v *vector) Durations() ([]time.Duration, []bool) {
+	if payload, ok := v.Payload().(Durationable); ok {
+		return payload.Durations()
+	}
+
+	return NA(v.length).Durations()
+}
+
 func (v *vector) Anies() ([]any, []bool) {
-	if payload, ok := v.payload.(Anyable); ok {
+	if payload, ok := v.Payload().(Anyable); ok {
 		return payload.Anies()
 	}
 
@@ -635,7 +734,7 @@ func (v *vector) Anies() ([]any, []bool) {
 }
 
 func (v *vector) AsInteger(options ...Option) Vector {
-	if payload, ok := v.payload.(Intable); ok {
+	if payload, ok := v.Payload().(Intable); ok {
 		values, na := payload.Integers()
 
 		return IntegerWithNA(values, na, options...)
@@ -645,7 +744,7 @@ func (v *vector) AsInteger(options ...Option) Vector {
 }
 
 func (v *vector) AsFloat(options ...Option) Vector {
-	if payload, ok := v.payload.(Floatable); ok {
+	if payload, ok := v.Payload().(Floatable); ok {
 		values, na := payload.Floats()
 
 		return FloatWithNA(values, na, options...)
@@ -655,7 +754,7 @@ func (v *vector) AsFloat(options ...Option) Vector {
 }
 
 func (v *vector) AsComplex(options ...Option) Vector {
-	if payload, ok := v.payload.(Complexable); ok {
+	if payload, ok := v.Payload().(Complexable); ok {
 		values, na := payload.Complexes()
 
 		return ComplexWithNA(values, na, options...)
@@ -665,7 +764,7 @@ func (v *vector) AsComplex(options ...Option) Vector {
 }
 
 func (v *vector) AsBoolean(options ...Option) Vector {
-	if payload, ok := v.payload.(Boolable); ok {
+	if payload, ok := v.Payload().(Boolable); ok {
 		values, na := payload.Booleans()
 
 		return BooleanWithNA(values, na, options...)
@@ -675,7 +774,7 @@ func (v *vector) AsBoolean(options ...Option) Vector {
 }
 
 func (v *vector) AsString(options ...Option) Vector {
-	if payload, ok := v.payload.(Stringable); ok {
+	if payload, ok := v.Payload().(Stringable); ok {
 		values, na := payload.Strings()
 
 		return StringWithNA(values, na, options...)
@@ -685,7 +784,7 @@ func (v *vector) AsString(options ...Option) Vector {
 }
 
 func (v *vector) AsTime(options ...Option) Vector {
-	if payload, ok := v.payload.(Timeable); ok {
+	if payload, ok := v.Payload().(Timeable); ok {
 		values, na := payload.Times()
 
 		return TimeWithNA(values, na, options...)
@@ -694,8 +793,89 @@ func (v *vector) AsTime(options ...Option) Vector {
 	return NA(v.length)
 }
 
+// AsDate converts the vector to calendar dates. Times are truncated to their dates.
+func (v *vector) AsDate(options ...Option) Vector {
+	if payload, ok := v.Payload().(Dateable); ok {
+		values, na := payload.Dates()
+
+		return DateWithNA(values, na, options...)
+	}
+
+	if payload, ok := v.Payload().(Timeable); ok {
+		values, na := payload.Times()
+
+		return DateWithNA(values, na, options...)
+	}
+
+	return NA(v.length)
+}
+
+func (v *vector) AsDuration(options ...Option) Vector {
+	if payload, ok := v.Payload().(Durationable); ok {
+		values, na := payload.Durations()
+
+		return DurationWithNA(values, na, options...)
+	}
+
+	return NA(v.length)
+}
+
+// AsDecimal converts the vector to fixed-precision decimals. The scale and the rounding mode are set by
+// OptionDecimalScale() and OptionDecimalRounding(), decimal vectors keep their own ones by default. Strings are
+// parsed exactly, so "0.1" becomes exactly 0.1.
+func (v *vector) AsDecimal(options ...Option) Vector {
+	scale := DefaultDecimalScale
+	rounding := DecimalRoundHalfUp
+	if decimal, ok := v.Payload().(*decimalPayload); ok {
+		scale = decimal.scale
+		rounding = decimal.rounding
+	}
+
+	conf := MergeOptions(options)
+	if conf.HasOption(keyOptionDecimalScale) {
+		scale = conf.Value(keyOptionDecimalScale).(int)
+	}
+	if conf.HasOption(keyOptionDecimalRounding) {
+		rounding = conf.Value(keyOptionDecimalRounding).(string)
+	}
+
+	values, na := decimalsOf(v.Payload(), scale, rounding)
+
+	options = append([]Option{OptionDecimalScale(scale), OptionDecimalRounding(rounding)}, options...)
+
+	return DecimalWithNA(values, na, options...)
+}
+
+// AsInt32 converts the vector to int32. Values which do not fit int32 become NA.
+func (v *vector) AsInt32(options ...Option) Vector {
+	values, na := narrowValuesOf[int32](v.Payload())
+
+	return Int32WithNA(values, na, options...)
+}
+
+// AsInt64 converts the vector to int64. Values which do not fit int64 become NA.
+func (v *vector) AsInt64(options ...Option) Vector {
+	values, na := narrowValuesOf[int64](v.Payload())
+
+	return Int64WithNA(values, na, options...)
+}
+
+// AsUint8 converts the vector to uint8. Values which do not fit uint8 become NA.
+func (v *vector) AsUint8(options ...Option) Vector {
+	values, na := narrowValuesOf[uint8](v.Payload())
+
+	return Uint8WithNA(values, na, options...)
+}
+
+// AsFloat32 converts the vector to float32.
+func (v *vector) AsFloat32(options ...Option) Vector {
+	values, na := narrowValuesOf[float32](v.Payload())
+
+	return Float32WithNA(values, na, options...)
+}
+
 func (v *vector) AsAny(options ...Option) Vector {
-	if payload, ok := v.payload.(Anyable); ok {
+	if payload, ok := v.Payload().(Anyable); ok {
 		values, na := payload.Anies()
 
 		return AnyWithNA(values, na, options...)
@@ -704,8 +884,103 @@ func (v *vector) AsAny(options ...Option) Vector {
 	return NA(v.length)
 }
 
+// AsFactor converts the vector to an unordered factor. Levels are set by OptionLevels(). If they are not provided,
+// the level set of a factor is kept and for other vectors it is formed from unique values in the sorted order.
+func (v *vector) AsFactor(options ...Option) Vector {
+	return v.asFactor(options, false)
+}
+
+// AsOrderedFactor converts the vector to an ordered factor. Levels are chosen in the same way as in AsFactor().
+func (v *vector) AsOrderedFactor(options ...Option) Vector {
+	return v.asFactor(options, true)
+}
+
+func (v *vector) asFactor(options []Option, ordered bool) Vector {
+	payload, ok := v.Payload().(Stringable)
+	if !ok {
+		return NA(v.length)
+	}
+
+	conf := MergeOptions(options)
+	var levels []string
+	if conf.HasOption(keyOptionFactorLevels) {
+		levels = conf.Value(keyOptionFactorLevels).([]string)
+	}
+	if conf.HasOption(keyOptionFactorOrdered) && conf.Value(keyOptionFactorOrdered).(bool) {
+		ordered = true
+	}
+
+	if len(levels) == 0 {
+		if categorical, ok := v.Payload().(Categorical); ok {
+			levels = categorical.Levels()
+		} else {
+			levels = v.sortedUniqueStrings()
+		}
+	}
+
+	values, na := payload.Strings()
+
+	factorOptions := append([]Option{}, options...)
+	factorOptions = append(factorOptions, OptionLevels(levels...), OptionFactorOrdered(ordered))
+
+	return FactorWithNA(values, na, factorOptions...)
+}
+
+// sortedUniqueStrings returns string representations of unique non-NA values in the order of SortedIndices().
+func (v *vector) sortedUniqueStrings() []string {
+	values, na := v.Strings()
+	indices := v.SortedIndices()
+
+	sorted := make([]string, 0, len(indices))
+	sortedNA := make([]bool, 0, len(indices))
+	for _, idx := range indices {
+		sorted = append(sorted, values[idx-1])
+		sortedNA = append(sortedNA, na[idx-1])
+	}
+
+	levels, _ := extendLevels([]string{}, sorted, sortedNA)
+
+	return levels
+}
+
+// Levels returns the level set of a factor or nil for other vectors.
+func (v *vector) Levels() []string {
+	if categorical, ok := v.Payload().(Categorical); ok {
+		return categorical.Levels()
+	}
+
+	return nil
+}
+
+// IsOrdered returns true if the vector is an ordered factor.
+func (v *vector) IsOrdered() bool {
+	if categorical, ok := v.Payload().(Categorical); ok {
+		return categorical.IsOrdered()
+	}
+
+	return false
+}
+
+// Relevel moves the provided levels of a factor to the beginning of its level set. Other vectors are returned as is.
+func (v *vector) Relevel(levels ...string) Vector {
+	if categorical, ok := v.Payload().(Categorical); ok {
+		return New(categorical.Relevel(levels...), v.Options()...)
+	}
+
+	return v
+}
+
+// DropLevels removes unused levels of a factor. Other vectors are returned as is.
+func (v *vector) DropLevels() Vector {
+	if categorical, ok := v.Payload().(Categorical); ok {
+		return New(categorical.DropLevels(), v.Options()...)
+	}
+
+	return v
+}
+
 func (v *vector) Find(needle any) int {
-	if finder, ok := v.payload.(Finder); ok {
+	if finder, ok := v.Payload().(Finder); ok {
 		return finder.Find(needle)
 	}
 
@@ -715,7 +990,7 @@ func (v *vector) Find(needle any) int {
 /* Finder interface */
 
 func (v *vector) FindAll(needle any) []int {
-	if finder, ok := v.payload.(Finder); ok {
+	if finder, ok := v.Payload().(Finder); ok {
 		return finder.FindAll(needle)
 	}
 
@@ -723,7 +998,7 @@ func (v *vector) FindAll(needle any) []int {
 }
 
 func (v *vector) Has(needle any) bool {
-	if finder, ok := v.payload.(Finder); ok {
+	if finder, ok := v.Payload().(Finder); ok {
 		return finder.Find(needle) > 0
 	}
 
@@ -733,7 +1008,11 @@ func (v *vector) Has(needle any) bool {
 /* Equalable interface */
 
 func (v *vector) Eq(val any) []bool {
-	if comparee, ok := v.payload.(Equalable); ok {
+	if vec, ok := val.(Vector); ok {
+		return trueElements(Equal(v, vec))
+	}
+
+	if comparee, ok := v.Payload().(Equalable); ok {
 		return comparee.Eq(val)
 	}
 
@@ -741,7 +1020,13 @@ func (v *vector) Eq(val any) []bool {
 }
 
 func (v *vector) Neq(val any) []bool {
-	if comparee, ok := v.payload.(Equalable); ok {
+	if vec, ok := val.(Vector); ok {
+		booleans, na := NotEqual(v, vec).Booleans()
+
+		return Or(booleans, na)
+	}
+
+	if comparee, ok := v.Payload().(Equalable); ok {
 		return comparee.Neq(val)
 	}
 
@@ -756,7 +1041,11 @@ func (v *vector) Neq(val any) []bool {
 /* Ordered interface */
 
 func (v *vector) Gt(val any) []bool {
-	if comparee, ok := v.payload.(Ordered); ok {
+	if vec, ok := val.(Vector); ok {
+		return trueElements(Greater(v, vec))
+	}
+
+	if comparee, ok := v.Payload().(Ordered); ok {
 		return comparee.Gt(val)
 	}
 
@@ -764,7 +1053,11 @@ func (v *vector) Gt(val any) []bool {
 }
 
 func (v *vector) Lt(val any) []bool {
-	if comparee, ok := v.payload.(Ordered); ok {
+	if vec, ok := val.(Vector); ok {
+		return trueElements(Less(v, vec))
+	}
+
+	if comparee, ok := v.Payload().(Ordered); ok {
 		return comparee.Lt(val)
 	}
 
@@ -772,7 +1065,11 @@ func (v *vector) Lt(val any) []bool {
 }
 
 func (v *vector) Gte(val any) []bool {
-	if comparee, ok := v.payload.(Ordered); ok {
+	if vec, ok := val.(Vector); ok {
+		return trueElements(GreaterOrEqual(v, vec))
+	}
+
+	if comparee, ok := v.Payload().(Ordered); ok {
 		return comparee.Gte(val)
 	}
 
@@ -780,7 +1077,11 @@ func (v *vector) Gte(val any) []bool {
 }
 
 func (v *vector) Lte(val any) []bool {
-	if comparee, ok := v.payload.(Ordered); ok {
+	if vec, ok := val.(Vector); ok {
+		return trueElements(LessOrEqual(v, vec))
+	}
+
+	if comparee, ok := v.Payload().(Ordered); ok {
 		return comparee.Lte(val)
 	}
 
@@ -788,7 +1089,7 @@ func (v *vector) Lte(val any) []bool {
 }
 
 func (v *vector) SortedIndices() []int {
-	if arrangeable, ok := v.payload.(Arrangeable); ok {
+	if arrangeable, ok := v.Payload().(Arrangeable); ok {
 		return arrangeable.SortedIndices()
 	}
 
@@ -798,7 +1099,7 @@ func (v *vector) SortedIndices() []int {
 /* Arrangeable interface */
 
 func (v *vector) SortedIndicesWithRanks() ([]int, []int) {
-	if arrangeable, ok := v.payload.(Arrangeable); ok {
+	if arrangeable, ok := v.Payload().(Arrangeable); ok {
 		return arrangeable.SortedIndicesWithRanks()
 	}
 
@@ -808,7 +1109,7 @@ func (v *vector) SortedIndicesWithRanks() ([]int, []int) {
 }
 
 func (v *vector) Unique() Vector {
-	if uniquer, ok := v.payload.(IsUniquer); ok {
+	if uniquer, ok := v.Payload().(IsUniquer); ok {
 		return v.Filter(uniquer.IsUnique())
 	}
 
@@ -820,7 +1121,7 @@ func (v *vector) Coalesce(vectors ...Vector) Vector {
 		return v
 	}
 
-	coalescer, ok := v.payload.(Coalescer)
+	coalescer, ok := v.Payload().(Coalescer)
 	if !ok {
 		return v
 	}
@@ -856,7 +1157,7 @@ func (v *vector) SetOption(option Option) bool {
 		return true
 	}
 
-	return v.payload.SetOption(option.Key(), option.Value())
+	return v.Payload().SetOption(option.Key(), option.Value())
 }
 
 // New creates a vector part of the future vector. This function is used by public functions which create
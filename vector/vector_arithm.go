@@ -28,7 +28,7 @@ func (v *vector) Add(vectors ...Vector) Vector {
 		return v
 	}
 
-	adder, ok := v.payload.(Adder)
+	adder, ok := v.Payload().(Adder)
 	if !ok {
 		return NA(v.length)
 	}
@@ -51,7 +51,7 @@ func (v *vector) Sub(vectors ...Vector) Vector {
 		return v
 	}
 
-	subber, ok := v.payload.(Subber)
+	subber, ok := v.Payload().(Subber)
 	if !ok {
 		return NA(v.length)
 	}
@@ -74,7 +74,7 @@ func (v *vector) Mul(vectors ...Vector) Vector {
 		return v
 	}
 
-	multiplier, ok := v.payload.(Multiplier)
+	multiplier, ok := v.Payload().(Multiplier)
 	if !ok {
 		return NA(v.length)
 	}
@@ -97,7 +97,7 @@ func (v *vector) Div(vectors ...Vector) Vector {
 		return v
 	}
 
-	divider, ok := v.payload.(Divider)
+	divider, ok := v.Payload().(Divider)
 	if !ok {
 		return NA(v.length)
 	}
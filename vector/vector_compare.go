@@ -0,0 +1,264 @@
+package vector
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+type compareOp int
+
+const (
+	compareEq compareOp = iota
+	compareNeq
+	compareGt
+	compareLt
+	compareGte
+	compareLte
+)
+
+const (
+	compareClassNA       = "na"
+	compareClassInteger  = "integer"
+	compareClassFloat    = "float"
+	compareClassComplex  = "complex"
+	compareClassString   = "string"
+	compareClassFactor   = "factor"
+	compareClassTime     = "time"
+	compareClassDuration = "duration"
+)
+
+// Equal compares elements of two vectors and returns a boolean vector where true means the corresponding
+// elements are equal. The second vector is fitted to the length of the first one in the same way as Adjust does.
+// Integer, float and complex vectors are promoted to the widest of their types. The result is NA where one of
+// the elements is NA or the vectors can't be compared.
+func Equal(x, y Vector) Vector {
+	return compareVectors(x, y, compareEq)
+}
+
+// NotEqual compares elements of two vectors like Equal does, but true means the elements are not equal.
+func NotEqual(x, y Vector) Vector {
+	return compareVectors(x, y, compareNeq)
+}
+
+// Greater compares elements of two vectors like Equal does, but true means the element of the first vector
+// is greater than the corresponding element of the second one. Complex vectors can't be compared this way.
+func Greater(x, y Vector) Vector {
+	return compareVectors(x, y, compareGt)
+}
+
+// Less compares elements of two vectors like Greater does, but true means the element of the first vector
+// is less than the corresponding element of the second one.
+func Less(x, y Vector) Vector {
+	return compareVectors(x, y, compareLt)
+}
+
+// GreaterOrEqual compares elements of two vectors like Greater does, but true means the element of the first
+// vector is greater than or equal to the corresponding element of the second one.
+func GreaterOrEqual(x, y Vector) Vector {
+	return compareVectors(x, y, compareGte)
+}
+
+// LessOrEqual compares elements of two vectors like Greater does, but true means the element of the first
+// vector is less than or equal to the corresponding element of the second one.
+func LessOrEqual(x, y Vector) Vector {
+	return compareVectors(x, y, compareLte)
+}
+
+func compareVectors(x, y Vector, op compareOp) Vector {
+	length := x.Len()
+	y = fitToLength(y, length)
+
+	xClass, yClass := compareClass(x), compareClass(y)
+	class := commonCompareClass(xClass, yClass)
+
+	var cmp []bool
+	ok := true
+	switch class {
+	case compareClassInteger:
+		xData, _ := x.Integers()
+		yData, _ := y.Integers()
+		cmp = compareValues(xData, yData, op, compareNumbers[int])
+	case compareClassFloat:
+		xData, _ := x.Floats()
+		yData, _ := y.Floats()
+		cmp = compareValues(xData, yData, op, compareNumbers[float64])
+	case compareClassComplex:
+		if op != compareEq && op != compareNeq {
+			ok = false
+			break
+		}
+		xData, _ := x.Complexes()
+		yData, _ := y.Complexes()
+		cmp = compareValues(xData, yData, op, func(a, b complex128) (int, bool) {
+			if a == b {
+				return 0, true
+			}
+
+			return 1, true
+		})
+	case compareClassString:
+		xData, _ := x.Strings()
+		yData, _ := y.Strings()
+		cmp = compareValues(xData, yData, op, compareStrings)
+	case compareClassFactor:
+		cmp, ok = compareFactors(x, y, op)
+	case compareClassTime:
+		xData, _ := x.Times()
+		yData, _ := y.Times()
+		cmp = compareValues(xData, yData, op, func(a, b time.Time) (int, bool) {
+			if a.Before(b) {
+				return -1, true
+			}
+			if a.After(b) {
+				return 1, true
+			}
+
+			return 0, true
+		})
+	case compareClassDuration:
+		xData, _ := x.Durations()
+		yData, _ := y.Durations()
+		cmp = compareValues(xData, yData, op, compareNumbers[time.Duration])
+	default:
+		ok = false
+	}
+
+	if !ok {
+		return BooleanWithNA(make([]bool, length), trueBooleanArr(length))
+	}
+
+	na := Or(x.IsNA(), y.IsNA())
+	for i := range cmp {
+		if na[i] {
+			cmp[i] = false
+		}
+	}
+
+	return BooleanWithNA(cmp, na)
+}
+
+func compareFactors(x, y Vector, op compareOp) ([]bool, bool) {
+	if op == compareEq || op == compareNeq {
+		xData, _ := x.Strings()
+		yData, _ := y.Strings()
+
+		return compareValues(xData, yData, op, compareStrings), true
+	}
+
+	if x.Type() != PayloadTypeFactor || y.Type() != PayloadTypeFactor || !x.IsOrdered() ||
+		!slices.Equal(x.Levels(), y.Levels()) {
+		return nil, false
+	}
+
+	xCodes, _ := x.Integers()
+	yCodes, _ := y.Integers()
+
+	return compareValues(xCodes, yCodes, op, compareNumbers[int]), true
+}
+
+func compareClass(vec Vector) string {
+	switch vec.Type() {
+	case PayloadTypeInteger, PayloadTypeBoolean, PayloadTypeInt32, PayloadTypeInt64, PayloadTypeUint8:
+		return compareClassInteger
+	case PayloadTypeFloat, PayloadTypeFloat32, PayloadTypeDecimal:
+		return compareClassFloat
+	case PayloadTypeComplex:
+		return compareClassComplex
+	case PayloadTypeString:
+		return compareClassString
+	case PayloadTypeFactor:
+		return compareClassFactor
+	case PayloadTypeTime, PayloadTypeDate:
+		return compareClassTime
+	case PayloadTypeDuration:
+		return compareClassDuration
+	}
+
+	return compareClassNA
+}
+
+func commonCompareClass(xClass, yClass string) string {
+	if xClass == yClass {
+		return xClass
+	}
+
+	numeric := map[string]int{
+		compareClassInteger: 1,
+		compareClassFloat:   2,
+		compareClassComplex: 3,
+	}
+	xRank, xNumeric := numeric[xClass]
+	yRank, yNumeric := numeric[yClass]
+	if xNumeric && yNumeric {
+		if xRank > yRank {
+			return xClass
+		}
+
+		return yClass
+	}
+
+	if xClass == compareClassFactor && yClass == compareClassString ||
+		xClass == compareClassString && yClass == compareClassFactor {
+		return compareClassFactor
+	}
+
+	return compareClassNA
+}
+
+// compareNumbers returns false if the numbers are unordered (one of them is NaN).
+func compareNumbers[T int | float64 | time.Duration](a, b T) (int, bool) {
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	case a == b:
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func compareStrings(a, b string) (int, bool) {
+	return strings.Compare(a, b), true
+}
+
+// compareValues compares elements pairwise. Unordered elements are only not equal.
+func compareValues[T any](x, y []T, op compareOp, cmp func(T, T) (int, bool)) []bool {
+	result := make([]bool, len(x))
+
+	for i := range x {
+		c, ordered := cmp(x[i], y[i])
+		if !ordered {
+			result[i] = op == compareNeq
+			continue
+		}
+
+		switch op {
+		case compareEq:
+			result[i] = c == 0
+		case compareNeq:
+			result[i] = c != 0
+		case compareGt:
+			result[i] = c > 0
+		case compareLt:
+			result[i] = c < 0
+		case compareGte:
+			result[i] = c >= 0
+		case compareLte:
+			result[i] = c <= 0
+		}
+	}
+
+	return result
+}
+
+// trueElements returns a boolean slice where true means the corresponding element of the boolean vector is true.
+// NA-elements are false.
+func trueElements(vec Vector) []bool {
+	booleans, na := vec.Booleans()
+
+	return And(booleans, Not(na))
+}
@@ -0,0 +1,120 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompareVectors(t *testing.T) {
+	day := time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name   string
+		result Vector
+		expect Vector
+	}{
+		{
+			name:   "integers",
+			result: Greater(Integer([]int{1, 5, 3}), Integer([]int{2, 2, 3})),
+			expect: Boolean([]bool{false, true, false}),
+		},
+		{
+			name:   "integer and float",
+			result: GreaterOrEqual(Integer([]int{1, 5, 3}), Float([]float64{1.5, 4.5, 3})),
+			expect: Boolean([]bool{false, true, true}),
+		},
+		{
+			name:   "float with NaN",
+			result: NotEqual(Float([]float64{math.NaN(), 1}), Float([]float64{math.NaN(), 1})),
+			expect: Boolean([]bool{true, false}),
+		},
+		{
+			name:   "recycled",
+			result: Less(Integer([]int{1, 2, 3, 4}), Integer([]int{2, 3})),
+			expect: Boolean([]bool{true, true, false, false}),
+		},
+		{
+			name: "with NA",
+			result: Equal(
+				IntegerWithNA([]int{1, 2, 3}, []bool{false, true, false}),
+				FloatWithNA([]float64{1, 2, 3}, []bool{false, false, true}),
+			),
+			expect: BooleanWithNA([]bool{true, false, false}, []bool{false, true, true}),
+		},
+		{
+			name:   "integer and complex",
+			result: Equal(Integer([]int{1, 2}), Complex([]complex128{1, 2 + 1i})),
+			expect: Boolean([]bool{true, false}),
+		},
+		{
+			name:   "ordered complexes",
+			result: Greater(Complex([]complex128{1, 2}), Complex([]complex128{0, 1})),
+			expect: BooleanWithNA([]bool{false, false}, []bool{true, true}),
+		},
+		{
+			name:   "strings",
+			result: LessOrEqual(String([]string{"a", "c", "b"}), String([]string{"b", "b", "b"})),
+			expect: Boolean([]bool{true, false, true}),
+		},
+		{
+			name: "ordered factors",
+			result: Greater(
+				String([]string{"low", "high", "mid"}).AsOrderedFactor(OptionLevels("low", "mid", "high")),
+				String([]string{"mid", "mid", "mid"}).AsOrderedFactor(OptionLevels("low", "mid", "high")),
+			),
+			expect: Boolean([]bool{false, true, false}),
+		},
+		{
+			name:   "factor and string",
+			result: Equal(String([]string{"a", "b"}).AsFactor(), String([]string{"a", "a"})),
+			expect: Boolean([]bool{true, false}),
+		},
+		{
+			name:   "time and date",
+			result: Less(Time([]time.Time{day, day.Add(48 * time.Hour)}), Date([]time.Time{day.Add(24 * time.Hour)})),
+			expect: Boolean([]bool{true, false}),
+		},
+		{
+			name:   "incomparable",
+			result: Equal(Integer([]int{1, 2}), String([]string{"1", "2"})),
+			expect: BooleanWithNA([]bool{false, false}, []bool{true, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_CompareWithVector(t *testing.T) {
+	price := FloatWithNA([]float64{10, 20, 30, 40}, []bool{false, false, true, false})
+	cost := Integer([]int{15, 15, 15, 40})
+
+	testData := []struct {
+		name   string
+		result []bool
+		expect []bool
+	}{
+		{name: "Eq", result: price.Eq(cost), expect: []bool{false, false, false, true}},
+		{name: "Neq", result: price.Neq(cost), expect: []bool{true, true, true, false}},
+		{name: "Gt", result: price.Gt(cost), expect: []bool{false, true, false, false}},
+		{name: "Lt", result: price.Lt(cost), expect: []bool{true, false, false, false}},
+		{name: "Gte", result: price.Gte(cost), expect: []bool{false, true, false, true}},
+		{name: "Lte", result: price.Lte(cost), expect: []bool{true, false, false, true}},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !reflect.DeepEqual(data.result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.expect))
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package vector
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// Binning interface is implemented by vectors to split numeric and time values into intervals.
+type Binning interface {
+	// Cut returns an ordered factor, levels of which are intervals between breaks. Breaks can be a slice of
+	// numbers (for numeric vectors), a slice of times (for time and date vectors) or an integer number of
+	// intervals of equal width between the minimum and the maximum of the vector, in which case the lowest value
+	// is always included. Intervals are (a,b] if rightClosed is true and [a,b) otherwise. If includeLowest is
+	// true, the first interval (or the last one for left-closed intervals) also includes its other bound.
+	// Labels replace the interval strings if they are provided. Values outside the breaks are NA.
+	Cut(breaks any, labels []string, rightClosed bool, includeLowest bool) Vector
+	// QCut splits a numeric vector into n intervals containing approximately equal number of elements.
+	// Intervals are right-closed, and their bounds are quantiles of the vector.
+	QCut(n int) Vector
+}
+
+func (v *vector) Cut(breaks any, labels []string, rightClosed bool, includeLowest bool) Vector {
+	var codes []int
+	var levels []string
+
+	switch {
+	case v.Type() == PayloadTypeTime || v.Type() == PayloadTypeDate:
+		times, na := v.Times()
+		edges, computed, ok := timeBreaks(breaks, times, na)
+		if !ok {
+			return NA(v.length)
+		}
+
+		includeLowest = includeLowest || computed
+		codes = cutCodes(times, na, edges, timeLess, rightClosed, includeLowest)
+		levels = intervalLabels(edges, func(t time.Time) string {
+			return t.Format(time.RFC3339)
+		}, rightClosed, includeLowest)
+	case coercionRanks[v.Type()] > 1 && v.Type() != PayloadTypeComplex:
+		floats, na := v.Floats()
+		na = append([]bool{}, na...)
+		for i, val := range floats {
+			na[i] = na[i] || math.IsNaN(val)
+		}
+
+		edges, computed, ok := floatBreaks(breaks, floats, na)
+		if !ok {
+			return NA(v.length)
+		}
+
+		includeLowest = includeLowest || computed
+		codes = cutCodes(floats, na, edges, func(a, b float64) bool {
+			return a < b
+		}, rightClosed, includeLowest)
+		levels = intervalLabels(edges, func(f float64) string {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}, rightClosed, includeLowest)
+	default:
+		return NA(v.length)
+	}
+
+	if labels != nil {
+		if len(labels) != len(levels) || len(uniqueLevels(labels)) != len(labels) {
+			return NA(v.length)
+		}
+		levels = labels
+	}
+
+	return New(factorPayloadFromCodes(codes, levels, true), v.Options()...)
+}
+
+func (v *vector) QCut(n int) Vector {
+	if n < 1 || coercionRanks[v.Type()] <= 1 || v.Type() == PayloadTypeComplex {
+		return NA(v.length)
+	}
+
+	probs := make([]float64, n+1)
+	for i := range probs {
+		probs[i] = float64(i) / float64(n)
+	}
+
+	quantiles, na := v.Ungroup().Quantile(probs, OptionNARemove(true)).Floats()
+	if len(quantiles) == 0 || na[0] {
+		return NA(v.length)
+	}
+
+	return v.Cut(quantiles, nil, true, true)
+}
+
+// floatBreaks returns sorted unique breaks for numeric values. The second returned value is true if breaks
+// were computed from the number of intervals.
+func floatBreaks(breaks any, values []float64, na []bool) ([]float64, bool, bool) {
+	var edges []float64
+
+	switch b := breaks.(type) {
+	case int:
+		if b < 1 {
+			return nil, false, false
+		}
+
+		min, max := math.Inf(1), math.Inf(-1)
+		for i, val := range values {
+			if na[i] {
+				continue
+			}
+			min = math.Min(min, val)
+			max = math.Max(max, val)
+		}
+		if min > max {
+			return nil, false, false
+		}
+		if min == max {
+			min, max = min-0.5, max+0.5
+		}
+
+		edges = make([]float64, b+1)
+		width := (max - min) / float64(b)
+		for i := range edges {
+			edges[i] = min + float64(i)*width
+		}
+		edges[b] = max
+
+		return edges, true, true
+	case []float64:
+		edges = append([]float64{}, b...)
+	case []int:
+		edges = make([]float64, len(b))
+		for i, val := range b {
+			edges[i] = float64(val)
+		}
+	default:
+		return nil, false, false
+	}
+
+	sort.Float64s(edges)
+	edges = slices.Compact(edges)
+	if len(edges) < 2 {
+		return nil, false, false
+	}
+
+	return edges, false, true
+}
+
+// timeBreaks returns sorted unique breaks for time values. The second returned value is true if breaks
+// were computed from the number of intervals.
+func timeBreaks(breaks any, values []time.Time, na []bool) ([]time.Time, bool, bool) {
+	var edges []time.Time
+
+	switch b := breaks.(type) {
+	case int:
+		if b < 1 {
+			return nil, false, false
+		}
+
+		var min, max time.Time
+		found := false
+		for i, val := range values {
+			if na[i] {
+				continue
+			}
+			if !found || val.Before(min) {
+				min = val
+			}
+			if !found || val.After(max) {
+				max = val
+			}
+			found = true
+		}
+		if !found {
+			return nil, false, false
+		}
+		if min.Equal(max) {
+			min, max = min.Add(-time.Second), max.Add(time.Second)
+		}
+
+		edges = make([]time.Time, b+1)
+		width := max.Sub(min) / time.Duration(b)
+		for i := range edges {
+			edges[i] = min.Add(time.Duration(i) * width)
+		}
+		edges[b] = max
+
+		return edges, true, true
+	case []time.Time:
+		edges = append([]time.Time{}, b...)
+	default:
+		return nil, false, false
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].Before(edges[j])
+	})
+	edges = slices.CompactFunc(edges, func(a, b time.Time) bool {
+		return a.Equal(b)
+	})
+	if len(edges) < 2 {
+		return nil, false, false
+	}
+
+	return edges, false, true
+}
+
+func timeLess(a, b time.Time) bool {
+	return a.Before(b)
+}
+
+// cutCodes returns one-based numbers of intervals between sorted edges for the values. Zero is returned for
+// NA-values and values outside the edges.
+func cutCodes[T any](values []T, na []bool, edges []T, less func(T, T) bool,
+	rightClosed bool, includeLowest bool) []int {
+	codes := make([]int, len(values))
+	last := len(edges) - 1
+
+	for i, val := range values {
+		if na[i] {
+			continue
+		}
+
+		if rightClosed {
+			idx := sort.Search(len(edges), func(j int) bool {
+				return !less(edges[j], val)
+			})
+			switch {
+			case idx == 0 && includeLowest && !less(val, edges[0]):
+				codes[i] = 1
+			case idx > 0 && idx <= last:
+				codes[i] = idx
+			}
+		} else {
+			idx := sort.Search(len(edges), func(j int) bool {
+				return less(val, edges[j])
+			})
+			switch {
+			case idx == len(edges) && includeLowest && !less(edges[last], val):
+				codes[i] = last
+			case idx > 0 && idx <= last:
+				codes[i] = idx
+			}
+		}
+	}
+
+	return codes
+}
+
+func intervalLabels[T any](edges []T, format func(T) string, rightClosed bool, includeLowest bool) []string {
+	labels := make([]string, len(edges)-1)
+
+	for i := range labels {
+		open, closed := "(", "]"
+		if !rightClosed {
+			open, closed = "[", ")"
+		}
+		if includeLowest && rightClosed && i == 0 {
+			open = "["
+		}
+		if includeLowest && !rightClosed && i == len(labels)-1 {
+			closed = "]"
+		}
+
+		labels[i] = open + format(edges[i]) + "," + format(edges[i+1]) + closed
+	}
+
+	return labels
+}
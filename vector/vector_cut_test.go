@@ -0,0 +1,145 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestVector_Cut(t *testing.T) {
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name          string
+		vec           Vector
+		breaks        any
+		labels        []string
+		rightClosed   bool
+		includeLowest bool
+		levels        []string
+		expect        Vector
+	}{
+		{
+			name:        "right-closed",
+			vec:         IntegerWithNA([]int{0, 1, 2, 3, 4, 5}, []bool{false, false, false, false, true, false}),
+			breaks:      []int{0, 2, 5},
+			rightClosed: true,
+			levels:      []string{"(0,2]", "(2,5]"},
+			expect:      StringWithNA([]string{"", "(0,2]", "(0,2]", "(2,5]", "", "(2,5]"}, []bool{true, false, false, false, true, false}),
+		},
+		{
+			name:          "right-closed with lowest",
+			vec:           Float([]float64{0, 1.5, 2.5, 6, math.NaN()}),
+			breaks:        []float64{5, 0, 2.5},
+			rightClosed:   true,
+			includeLowest: true,
+			levels:        []string{"[0,2.5]", "(2.5,5]"},
+			expect:        StringWithNA([]string{"[0,2.5]", "[0,2.5]", "[0,2.5]", "", ""}, []bool{false, false, false, true, true}),
+		},
+		{
+			name:   "left-closed",
+			vec:    Integer([]int{0, 2, 4, 5}),
+			breaks: []int{0, 2, 5},
+			levels: []string{"[0,2)", "[2,5)"},
+			expect: StringWithNA([]string{"[0,2)", "[2,5)", "[2,5)", ""}, []bool{false, false, false, true}),
+		},
+		{
+			name:          "left-closed with lowest",
+			vec:           Integer([]int{0, 2, 4, 5}),
+			breaks:        []int{0, 2, 5},
+			includeLowest: true,
+			levels:        []string{"[0,2)", "[2,5]"},
+			expect:        String([]string{"[0,2)", "[2,5]", "[2,5]", "[2,5]"}),
+		},
+		{
+			name:        "number of intervals",
+			vec:         Integer([]int{1, 2, 3, 4, 5}),
+			breaks:      2,
+			rightClosed: true,
+			levels:      []string{"[1,3]", "(3,5]"},
+			expect:      String([]string{"[1,3]", "[1,3]", "[1,3]", "(3,5]", "(3,5]"}),
+		},
+		{
+			name:        "labels",
+			vec:         Integer([]int{1, 5, 10}),
+			breaks:      []int{0, 5, 10},
+			labels:      []string{"low", "high"},
+			rightClosed: true,
+			levels:      []string{"low", "high"},
+			expect:      String([]string{"low", "low", "high"}),
+		},
+		{
+			name:        "times",
+			vec:         Time([]time.Time{day, day.Add(36 * time.Hour)}),
+			breaks:      []time.Time{day.Add(-24 * time.Hour), day, day.Add(48 * time.Hour)},
+			rightClosed: true,
+			levels: []string{
+				"(2022-12-31T00:00:00Z,2023-01-01T00:00:00Z]",
+				"(2023-01-01T00:00:00Z,2023-01-03T00:00:00Z]",
+			},
+			expect: String([]string{
+				"(2022-12-31T00:00:00Z,2023-01-01T00:00:00Z]",
+				"(2023-01-01T00:00:00Z,2023-01-03T00:00:00Z]",
+			}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			cut := data.vec.Cut(data.breaks, data.labels, data.rightClosed, data.includeLowest)
+
+			if !cut.IsOrdered() {
+				t.Error("Result is not an ordered factor")
+			}
+			if !reflect.DeepEqual(cut.Levels(), data.levels) {
+				t.Error(fmt.Sprintf("Levels (%v) are not equal to expected (%v)", cut.Levels(), data.levels))
+			}
+
+			strs, na := cut.Strings()
+			expectedStrs, expectedNA := data.expect.Strings()
+			if !reflect.DeepEqual(strs, expectedStrs) || !reflect.DeepEqual(na, expectedNA) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", cut, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_CutInvalid(t *testing.T) {
+	testData := []struct {
+		name string
+		cut  Vector
+	}{
+		{name: "string vector", cut: String([]string{"a", "b"}).Cut([]int{0, 1}, nil, true, false)},
+		{name: "single break", cut: Integer([]int{1, 2}).Cut([]int{1, 1}, nil, true, false)},
+		{name: "wrong labels", cut: Integer([]int{1, 2}).Cut([]int{0, 1, 2}, []string{"a"}, true, false)},
+		{name: "duplicate labels", cut: Integer([]int{1, 2}).Cut([]int{0, 1, 2}, []string{"a", "a"}, true, false)},
+		{name: "time breaks", cut: Integer([]int{1, 2}).Cut([]time.Time{{}, {}}, nil, true, false)},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if data.cut.Type() != PayloadTypeNA || data.cut.Len() != 2 {
+				t.Error(fmt.Sprintf("Result (%v) is not an NA-vector", data.cut))
+			}
+		})
+	}
+}
+
+func TestVector_QCut(t *testing.T) {
+	vec := IntegerWithNA([]int{1, 2, 3, 4, 5, 6, 7, 8, 0}, []bool{false, false, false, false, false, false, false,
+		false, true})
+
+	cut := vec.QCut(4)
+	levels := []string{"[1,2.75]", "(2.75,4.5]", "(4.5,6.25]", "(6.25,8]"}
+	if !reflect.DeepEqual(cut.Levels(), levels) {
+		t.Error(fmt.Sprintf("Levels (%v) are not equal to expected (%v)", cut.Levels(), levels))
+	}
+
+	codes, na := cut.Integers()
+	if !reflect.DeepEqual(codes, []int{1, 1, 2, 2, 3, 3, 4, 4, 0}) ||
+		!reflect.DeepEqual(na, []bool{false, false, false, false, false, false, false, false, true}) {
+		t.Error(fmt.Sprintf("Result (%v) is not equal to expected", cut))
+	}
+}
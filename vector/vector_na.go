@@ -0,0 +1,245 @@
+package vector
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	InterpolateLinear  = "linear"
+	InterpolateNearest = "nearest"
+	InterpolateTime    = "time"
+)
+
+// NAFiller interface contains functions which replace NA-values of a vector. Every function returns a vector of
+// the same length as the source one. If the vector is grouped, values are never taken from another group.
+type NAFiller interface {
+	ReplaceNA(value any) Vector
+	FillDown() Vector
+	FillUp() Vector
+	Interpolate(method string, options ...Option) Vector
+}
+
+// ReplaceNA returns the vector with NA-values replaced by the value. The value is converted to the type of
+// the vector in the same way as Coalesce does.
+func (v *vector) ReplaceNA(value any) Vector {
+	if !v.HasNA() {
+		return v
+	}
+
+	return v.Coalesce(vectorFromValues([]any{value}))
+}
+
+// FillDown returns the vector where every NA-value is replaced by the last previous non-NA value (of the same
+// group). Leading NA-values stay NA.
+func (v *vector) FillDown() Vector {
+	return v.fill(false)
+}
+
+// FillUp returns the vector where every NA-value is replaced by the first next non-NA value (of the same group).
+// Trailing NA-values stay NA.
+func (v *vector) FillUp() Vector {
+	return v.fill(true)
+}
+
+func (v *vector) fill(up bool) Vector {
+	if !v.HasNA() {
+		return v
+	}
+
+	isNA := v.IsNA()
+	indices := make([]int, v.length)
+
+	for _, group := range v.windowGroups() {
+		last := 0
+		for i := range group {
+			if up {
+				i = len(group) - 1 - i
+			}
+
+			idx := group[i]
+			if !isNA[idx-1] {
+				last = idx
+			}
+			indices[idx-1] = last
+		}
+	}
+
+	return v.ByIndices(indices)
+}
+
+// Interpolate returns the vector where NA-values between non-NA values (of the same group) are interpolated.
+// Leading and trailing NA-values stay NA. Possible methods are:
+//   - InterpolateLinear - values are interpolated linearly supposing elements are equally spaced.
+//   - InterpolateNearest - the value of the nearest non-NA element is taken, the previous one wins a tie.
+//   - InterpolateTime - values are interpolated linearly with weights proportional to time distances between
+//     elements. Times of the elements are provided with OptionInterpolationTimes(times) and are supposed to be
+//     ascending.
+//
+// Linear and time-weighted interpolation is applicable to numeric vectors (which become float) and to time and
+// date vectors, nearest interpolation is applicable to vectors of any type. NA vector is returned if the method
+// can't be applied.
+func (v *vector) Interpolate(method string, options ...Option) Vector {
+	conf := MergeOptions(options)
+
+	positions := make([]float64, v.length)
+	positionsNA := make([]bool, v.length)
+	switch method {
+	case InterpolateLinear, InterpolateNearest:
+		for i := range positions {
+			positions[i] = float64(i)
+		}
+	case InterpolateTime:
+		if !conf.HasOption(keyOptionInterpolationTimes) {
+			return NA(v.length, v.Options()...)
+		}
+
+		times := conf.Value(keyOptionInterpolationTimes).(Vector)
+		if times.Len() != v.length {
+			return NA(v.length, v.Options()...)
+		}
+
+		// positions are offsets from the first known time, so nanoseconds are not lost in float64
+		timeValues, timeNA := times.Times()
+		var base time.Time
+		for i, t := range timeValues {
+			if !timeNA[i] {
+				base = t
+				break
+			}
+		}
+		for i, t := range timeValues {
+			positions[i] = float64(t.Sub(base))
+		}
+		positionsNA = timeNA
+	default:
+		return NA(v.length, v.Options()...)
+	}
+
+	if method == InterpolateNearest {
+		return v.ByIndices(v.nearestIndices(positions, positionsNA))
+	}
+
+	return v.interpolateLinear(positions, positionsNA)
+}
+
+func (v *vector) interpolateLinear(positions []float64, positionsNA []bool) Vector {
+	if v.Type() == PayloadTypeTime || v.Type() == PayloadTypeDate {
+		return v.interpolateTimes(positions, positionsNA)
+	}
+
+	if coercionRanks[v.Type()] < 2 || v.Type() == PayloadTypeComplex {
+		return NA(v.length, v.Options()...)
+	}
+
+	values, na := v.Floats()
+	data := append([]float64{}, values...)
+	dataNA := append([]bool{}, na...)
+
+	v.interpolationWeights(na, positions, positionsNA, func(idx, prev, next int, weight float64) {
+		data[idx-1] = values[prev-1] + (values[next-1]-values[prev-1])*weight
+		dataNA[idx-1] = false
+	})
+
+	return FloatWithNA(data, dataNA, v.Options()...)
+}
+
+// interpolateTimes interpolates time or date values by offsets from the previous known value, so non-NA values
+// (and their locations) are kept intact and interpolated values get the location of the previous value.
+func (v *vector) interpolateTimes(positions []float64, positionsNA []bool) Vector {
+	times, na := v.Times()
+	data := append([]time.Time{}, times...)
+	dataNA := append([]bool{}, na...)
+
+	v.interpolationWeights(na, positions, positionsNA, func(idx, prev, next int, weight float64) {
+		offset := float64(times[next-1].Sub(times[prev-1])) * weight
+		data[idx-1] = times[prev-1].Add(time.Duration(math.Round(offset)))
+		dataNA[idx-1] = false
+	})
+
+	if v.Type() == PayloadTypeDate {
+		return DateWithNA(data, dataNA, v.Options()...)
+	}
+
+	return TimeWithNA(data, dataNA, v.Options()...)
+}
+
+// interpolationWeights calls fn for every NA-element, which lies between known neighbours, with indices of
+// the element and its neighbours and the relative distance from the previous neighbour.
+func (v *vector) interpolationWeights(na []bool, positions []float64, positionsNA []bool,
+	fn func(idx, prev, next int, weight float64)) {
+	for _, group := range v.windowGroups() {
+		prev, next := knownNeighbours(group, na, positionsNA)
+		for i, idx := range group {
+			if !na[idx-1] || prev[i] == 0 || next[i] == 0 {
+				continue
+			}
+
+			x0, x1 := positions[prev[i]-1], positions[next[i]-1]
+			weight := 0.0
+			if x1 != x0 {
+				weight = (positions[idx-1] - x0) / (x1 - x0)
+			}
+
+			fn(idx, prev[i], next[i], weight)
+		}
+	}
+}
+
+// nearestIndices returns indices of elements, values of which are taken for the elements of the vector by
+// the nearest interpolation. Non-NA elements keep their own values.
+func (v *vector) nearestIndices(positions []float64, positionsNA []bool) []int {
+	isNA := v.IsNA()
+	indices := make([]int, v.length)
+
+	for _, group := range v.windowGroups() {
+		prev, next := knownNeighbours(group, isNA, positionsNA)
+		for i, idx := range group {
+			switch {
+			case !isNA[idx-1]:
+				indices[idx-1] = idx
+			case prev[i] == 0 || next[i] == 0:
+			case positions[idx-1]-positions[prev[i]-1] <= positions[next[i]-1]-positions[idx-1]:
+				indices[idx-1] = prev[i]
+			default:
+				indices[idx-1] = next[i]
+			}
+		}
+	}
+
+	return indices
+}
+
+// knownNeighbours returns for every NA-element of the group with known position indices of the previous and
+// the next elements, which have both value and position. Zero means there is no such element.
+func knownNeighbours(group []int, na []bool, positionsNA []bool) ([]int, []int) {
+	prev := make([]int, len(group))
+	next := make([]int, len(group))
+
+	last := 0
+	for i, idx := range group {
+		if positionsNA[idx-1] {
+			continue
+		}
+		if na[idx-1] {
+			prev[i] = last
+		} else {
+			last = idx
+		}
+	}
+
+	last = 0
+	for i := len(group) - 1; i >= 0; i-- {
+		idx := group[i]
+		if positionsNA[idx-1] {
+			continue
+		}
+		if na[idx-1] {
+			next[i] = last
+		} else {
+			last = idx
+		}
+	}
+
+	return prev, next
+}
@@ -0,0 +1,183 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestVector_ReplaceNA(t *testing.T) {
+	testData := []struct {
+		name   string
+		vec    Vector
+		value  any
+		expect Vector
+	}{
+		{
+			name:   "integer",
+			vec:    IntegerWithNA([]int{1, 0, 3}, []bool{false, true, false}),
+			value:  10,
+			expect: Integer([]int{1, 10, 3}),
+		},
+		{
+			name:   "float by integer",
+			vec:    FloatWithNA([]float64{1.5, 0}, []bool{false, true}),
+			value:  2,
+			expect: Float([]float64{1.5, 2}),
+		},
+		{
+			name:   "string",
+			vec:    StringWithNA([]string{"", "b"}, []bool{true, false}),
+			value:  "a",
+			expect: String([]string{"a", "b"}),
+		},
+		{
+			name:   "nil",
+			vec:    IntegerWithNA([]int{1, 0}, []bool{false, true}),
+			value:  nil,
+			expect: IntegerWithNA([]int{1, 0}, []bool{false, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.vec.ReplaceNA(data.value)
+			if !CompareVectorsForTest(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_Fill(t *testing.T) {
+	vec := IntegerWithNA([]int{0, 1, 0, 0, 4, 0}, []bool{true, false, true, true, false, true})
+	grouped := IntegerWithNA([]int{1, 0, 0, 2, 0, 0}, []bool{false, true, true, false, true, true}).
+		GroupByIndices(GroupIndex{{1, 3, 5}, {2, 4, 6}})
+
+	testData := []struct {
+		name   string
+		result Vector
+		expect Vector
+	}{
+		{
+			name:   "down",
+			result: vec.FillDown(),
+			expect: IntegerWithNA([]int{0, 1, 1, 1, 4, 4}, []bool{true, false, false, false, false, false}),
+		},
+		{
+			name:   "up",
+			result: vec.FillUp(),
+			expect: IntegerWithNA([]int{1, 1, 4, 4, 4, 0}, []bool{false, false, false, false, false, true}),
+		},
+		{
+			name:   "grouped down",
+			result: grouped.FillDown(),
+			expect: IntegerWithNA([]int{1, 0, 1, 2, 1, 2}, []bool{false, true, false, false, false, false}),
+		},
+		{
+			name:   "grouped up",
+			result: grouped.FillUp(),
+			expect: IntegerWithNA([]int{1, 2, 0, 2, 0, 0}, []bool{false, false, true, false, true, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_Interpolate(t *testing.T) {
+	start := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	times := Time([]time.Time{start, start.Add(time.Hour), start.Add(4 * time.Hour), start.Add(5 * time.Hour)})
+	precise := time.Date(2023, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	zoned := time.Date(2023, 3, 1, 23, 0, 0, 987654321, time.FixedZone("JST", 9*60*60))
+
+	testData := []struct {
+		name    string
+		vec     Vector
+		method  string
+		options []Option
+		expect  Vector
+	}{
+		{
+			name:   "linear float",
+			vec:    FloatWithNA([]float64{0, 1, 0, 0, 4, 0}, []bool{true, false, true, true, false, true}),
+			method: InterpolateLinear,
+			expect: FloatWithNA([]float64{0, 1, 2, 3, 4, 0}, []bool{true, false, false, false, false, true}),
+		},
+		{
+			name:   "linear integer",
+			vec:    IntegerWithNA([]int{1, 0, 2}, []bool{false, true, false}),
+			method: InterpolateLinear,
+			expect: Float([]float64{1, 1.5, 2}),
+		},
+		{
+			name:   "nearest",
+			vec:    StringWithNA([]string{"a", "", "", "", "b"}, []bool{false, true, true, true, false}),
+			method: InterpolateNearest,
+			expect: String([]string{"a", "a", "a", "b", "b"}),
+		},
+		{
+			name:    "time-weighted",
+			vec:     FloatWithNA([]float64{0, 0, 0, 10}, []bool{false, true, true, false}),
+			method:  InterpolateTime,
+			options: []Option{OptionInterpolationTimes(times)},
+			expect:  Float([]float64{0, 2, 8, 10}),
+		},
+		{
+			name: "time values",
+			vec: TimeWithNA([]time.Time{start, {}, start.Add(2 * time.Hour)},
+				[]bool{false, true, false}),
+			method: InterpolateLinear,
+			expect: Time([]time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour)}),
+		},
+		{
+			name: "time values with nanoseconds and zones",
+			vec: TimeWithNA([]time.Time{precise, {}, zoned, {}, {}, precise},
+				[]bool{false, true, false, true, true, false}),
+			method: InterpolateLinear,
+			expect: Time([]time.Time{precise, precise.Add(zoned.Sub(precise) / 2), zoned,
+				zoned.Add(precise.Sub(zoned) / 3), zoned.Add(2 * precise.Sub(zoned) / 3), precise}),
+		},
+		{
+			name:   "time-weighted by nanoseconds",
+			vec:    FloatWithNA([]float64{0, 0, 3}, []bool{false, true, false}),
+			method: InterpolateTime,
+			options: []Option{OptionInterpolationTimes(Time([]time.Time{precise, precise.Add(1),
+				precise.Add(3)}))},
+			expect: Float([]float64{0, 1, 3}),
+		},
+		{
+			name: "grouped",
+			vec: FloatWithNA([]float64{0, 10, 0, 0, 2, 20}, []bool{false, false, true, true, false, false}).
+				GroupByIndices(GroupIndex{{1, 3, 5}, {2, 4, 6}}),
+			method: InterpolateLinear,
+			expect: Float([]float64{0, 10, 1, 15, 2, 20}),
+		},
+		{
+			name:   "time-weighted without times",
+			vec:    Float([]float64{1, 2}),
+			method: InterpolateTime,
+			expect: NA(2),
+		},
+		{
+			name:   "linear string",
+			vec:    String([]string{"a", "b"}),
+			method: InterpolateLinear,
+			expect: NA(2),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.vec.Interpolate(data.method, data.options...)
+			if !CompareVectorsForTest(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
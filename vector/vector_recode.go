@@ -0,0 +1,172 @@
+package vector
+
+import (
+	"reflect"
+	"time"
+)
+
+// Recode replaces elements of the vector by values from the mapping, where keys are compared with values
+// returned by Pick. Elements, which are not in the mapping, get the default value, and a nil value means NA.
+// NA-elements stay NA unless the mapping has a nil key. Elements, which can't be map keys (like slices or maps),
+// always get the default value. Int32, int64 and uint8 elements are converted to int if the mapping has int keys,
+// so they match keys like 1 or 2. The type of the resulting vector is inferred from
+// the values: integers mixed with floats become floats, floats mixed with complexes become complexes, and
+// other mixes of types result in an Any-vector.
+func (v *vector) Recode(mapping map[any]any, def any) Vector {
+	values := make([]any, v.length)
+
+	intKeys := false
+	for key := range mapping {
+		if _, ok := key.(int); ok {
+			intKeys = true
+			break
+		}
+	}
+
+	for i := 0; i < v.length; i++ {
+		key := v.Pick(i + 1)
+		if val, ok := recodeLookup(mapping, key, intKeys); ok {
+			values[i] = val
+		} else if key == nil {
+			values[i] = nil
+		} else {
+			values[i] = def
+		}
+	}
+
+	return vectorFromValues(values, v.Options()...)
+}
+
+// recodeLookup looks the element up in the mapping. Non-comparable elements are never found and narrow integers
+// are looked up as int if there are int keys in the mapping.
+func recodeLookup(mapping map[any]any, key any, intKeys bool) (any, bool) {
+	if key != nil && !reflect.TypeOf(key).Comparable() {
+		return nil, false
+	}
+
+	if val, ok := mapping[key]; ok {
+		return val, true
+	}
+
+	if !intKeys {
+		return nil, false
+	}
+
+	var intKey int
+	switch num := key.(type) {
+	case int32:
+		intKey = int(num)
+	case int64:
+		intKey = int(num)
+	case uint8:
+		intKey = int(num)
+	default:
+		return nil, false
+	}
+
+	val, ok := mapping[intKey]
+
+	return val, ok
+}
+
+// vectorFromValues creates a vector of the type inferred from the values, where nil values are NA.
+func vectorFromValues(values []any, options ...Option) Vector {
+	valType := ""
+	for _, val := range values {
+		if val == nil {
+			continue
+		}
+
+		curType := valueType(val)
+		switch {
+		case valType == "" || valType == curType:
+			valType = curType
+		case coercionRanks[valType] > 1 && coercionRanks[curType] > 1:
+			if coercionRanks[curType] > coercionRanks[valType] {
+				valType = curType
+			}
+		default:
+			valType = PayloadTypeAny
+		}
+	}
+
+	na := make([]bool, len(values))
+	for i, val := range values {
+		na[i] = val == nil
+	}
+
+	switch valType {
+	case "":
+		return NA(len(values), options...)
+	case PayloadTypeBoolean:
+		return BooleanWithNA(typedValues[bool](values), na, options...)
+	case PayloadTypeInteger:
+		return IntegerWithNA(typedValues[int](values), na, options...)
+	case PayloadTypeFloat:
+		floats := make([]float64, len(values))
+		for i, val := range values {
+			switch num := val.(type) {
+			case int:
+				floats[i] = float64(num)
+			case float64:
+				floats[i] = num
+			}
+		}
+
+		return FloatWithNA(floats, na, options...)
+	case PayloadTypeComplex:
+		complexes := make([]complex128, len(values))
+		for i, val := range values {
+			switch num := val.(type) {
+			case int:
+				complexes[i] = complex(float64(num), 0)
+			case float64:
+				complexes[i] = complex(num, 0)
+			case complex128:
+				complexes[i] = num
+			}
+		}
+
+		return ComplexWithNA(complexes, na, options...)
+	case PayloadTypeString:
+		return StringWithNA(typedValues[string](values), na, options...)
+	case PayloadTypeTime:
+		return TimeWithNA(typedValues[time.Time](values), na, options...)
+	case PayloadTypeDuration:
+		return DurationWithNA(typedValues[time.Duration](values), na, options...)
+	}
+
+	return AnyWithNA(values, na, options...)
+}
+
+func valueType(val any) string {
+	switch val.(type) {
+	case bool:
+		return PayloadTypeBoolean
+	case int:
+		return PayloadTypeInteger
+	case float64:
+		return PayloadTypeFloat
+	case complex128:
+		return PayloadTypeComplex
+	case string:
+		return PayloadTypeString
+	case time.Time:
+		return PayloadTypeTime
+	case time.Duration:
+		return PayloadTypeDuration
+	}
+
+	return PayloadTypeAny
+}
+
+func typedValues[T any](values []any) []T {
+	typed := make([]T, len(values))
+	for i, val := range values {
+		if val != nil {
+			typed[i] = val.(T)
+		}
+	}
+
+	return typed
+}
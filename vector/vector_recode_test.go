@@ -0,0 +1,96 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVector_Recode(t *testing.T) {
+	testData := []struct {
+		name    string
+		vec     Vector
+		mapping map[any]any
+		def     any
+		expect  Vector
+	}{
+		{
+			name:    "strings to strings",
+			vec:     StringWithNA([]string{"a", "b", "c", ""}, []bool{false, false, false, true}),
+			mapping: map[any]any{"a": "first", "b": "second"},
+			def:     "other",
+			expect:  StringWithNA([]string{"first", "second", "other", ""}, []bool{false, false, false, true}),
+		},
+		{
+			name:    "integers to floats",
+			vec:     Integer([]int{1, 2, 3}),
+			mapping: map[any]any{1: 0.5, 2: 2},
+			def:     nil,
+			expect:  FloatWithNA([]float64{0.5, 2, 0}, []bool{false, false, true}),
+		},
+		{
+			name:    "NA key",
+			vec:     IntegerWithNA([]int{1, 0}, []bool{false, true}),
+			mapping: map[any]any{nil: "missing"},
+			def:     "present",
+			expect:  String([]string{"present", "missing"}),
+		},
+		{
+			name:    "factor to booleans",
+			vec:     String([]string{"yes", "no", "yes"}).AsFactor(),
+			mapping: map[any]any{"yes": true, "no": false},
+			def:     nil,
+			expect:  Boolean([]bool{true, false, true}),
+		},
+		{
+			name:    "mixed types",
+			vec:     Integer([]int{1, 2}),
+			mapping: map[any]any{1: "one", 2: true},
+			def:     nil,
+			expect:  Any([]any{"one", true}),
+		},
+		{
+			name:    "non-comparable elements",
+			vec:     Any([]any{[]int{1}, "x", map[string]int{"x": 1}}),
+			mapping: map[any]any{"x": 1},
+			def:     0,
+			expect:  Integer([]int{0, 1, 0}),
+		},
+		{
+			name:    "int32 to int keys",
+			vec:     Int32([]int32{1, 2, 3}),
+			mapping: map[any]any{1: "one", 2: "two"},
+			def:     "many",
+			expect:  String([]string{"one", "two", "many"}),
+		},
+		{
+			name:    "int64 to int keys",
+			vec:     Int64([]int64{1, 2}),
+			mapping: map[any]any{1: "one", int64(2): "two"},
+			def:     nil,
+			expect:  String([]string{"one", "two"}),
+		},
+		{
+			name:    "uint8 to int keys",
+			vec:     Uint8([]uint8{1, 200}),
+			mapping: map[any]any{200: 2.5},
+			def:     0,
+			expect:  Float([]float64{0, 2.5}),
+		},
+		{
+			name:    "all NA",
+			vec:     Integer([]int{1, 2}),
+			mapping: map[any]any{},
+			def:     nil,
+			expect:  NA(2),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.vec.Recode(data.mapping, data.def)
+			if !CompareVectorsForTest(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
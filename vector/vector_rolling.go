@@ -0,0 +1,290 @@
+package vector
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	RollingAlignLeft   = "left"
+	RollingAlignCenter = "center"
+	RollingAlignRight  = "right"
+)
+
+// Rolling is a sliding window view of a vector. It is created by Vector.Rolling() and provides aggregation functions
+// which are calculated for the window around every element of the vector. Windows never cross group boundaries of
+// a grouped vector.
+//
+// NA-values are skipped inside a window. If the number of non-NA values in a window is less than minPeriods
+// (equals to the window size by default), the result for the element is NA.
+type Rolling struct {
+	vec        *vector
+	window     int
+	align      string
+	minPeriods int
+}
+
+// Rolling returns a sliding window view of the vector with the given window size. Possible options are:
+//   - OptionRollingAlign(align) - position of the window relatively to the current element. RollingAlignRight
+//     (default) means the window ends at the current element, RollingAlignLeft - starts at the current element,
+//     RollingAlignCenter - the current element is in the middle of the window.
+//   - OptionRollingMinPeriods(n) - the minimal number of non-NA values in a window required to calculate the result.
+func (v *vector) Rolling(window int, options ...Option) *Rolling {
+	conf := MergeOptions(options)
+
+	rolling := &Rolling{
+		vec:        v,
+		window:     window,
+		align:      RollingAlignRight,
+		minPeriods: window,
+	}
+
+	if conf.HasOption(keyOptionRollingAlign) {
+		rolling.align = conf.Value(keyOptionRollingAlign).(string)
+	}
+
+	if conf.HasOption(keyOptionRollingMinPeriods) {
+		rolling.minPeriods = conf.Value(keyOptionRollingMinPeriods).(int)
+	}
+
+	if rolling.minPeriods < 1 {
+		rolling.minPeriods = 1
+	}
+
+	return rolling
+}
+
+// Sum returns the sum of every window.
+func (r *Rolling) Sum() Vector {
+	switch payload := r.vec.Payload().(type) {
+	case *integerPayload:
+		data, na := rollingApply(r, payload.data, genRollingSum[int])
+		return IntegerWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_sum"))
+	case *floatPayload:
+		data, na := rollingApply(r, payload.data, genRollingSum[float64])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_sum"))
+	}
+
+	return r.reduce(func(vec Vector) Vector { return vec.Sum() }, "_rolling_sum")
+}
+
+// Mean returns the mean of every window.
+func (r *Rolling) Mean() Vector {
+	switch payload := r.vec.Payload().(type) {
+	case *integerPayload:
+		data, na := rollingApply(r, payload.data, genRollingMean[int])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_mean"))
+	case *floatPayload:
+		data, na := rollingApply(r, payload.data, genRollingMean[float64])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_mean"))
+	}
+
+	return r.reduce(func(vec Vector) Vector { return vec.Mean() }, "_rolling_mean")
+}
+
+// Min returns the minimum of every window.
+func (r *Rolling) Min() Vector {
+	switch payload := r.vec.Payload().(type) {
+	case *integerPayload:
+		data, na := rollingApply(r, payload.data, genRollingMin[int])
+		return IntegerWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_min"))
+	case *floatPayload:
+		data, na := rollingApply(r, payload.data, genRollingMin[float64])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_min"))
+	}
+
+	return r.reduce(func(vec Vector) Vector { return vec.Min() }, "_rolling_min")
+}
+
+// Max returns the maximum of every window.
+func (r *Rolling) Max() Vector {
+	switch payload := r.vec.Payload().(type) {
+	case *integerPayload:
+		data, na := rollingApply(r, payload.data, genRollingMax[int])
+		return IntegerWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_max"))
+	case *floatPayload:
+		data, na := rollingApply(r, payload.data, genRollingMax[float64])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_max"))
+	}
+
+	return r.reduce(func(vec Vector) Vector { return vec.Max() }, "_rolling_max")
+}
+
+// Median returns the median of every window.
+func (r *Rolling) Median() Vector {
+	switch payload := r.vec.Payload().(type) {
+	case *integerPayload:
+		data, na := rollingApply(r, payload.data, genRollingMedian[int])
+		return IntegerWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_median"))
+	case *floatPayload:
+		data, na := rollingApply(r, payload.data, genRollingMedian[float64])
+		return FloatWithNA(data, na, OptionVectorName(r.vec.Name()+"_rolling_median"))
+	}
+
+	return r.reduce(func(vec Vector) Vector { return vec.Median() }, "_rolling_median")
+}
+
+// Reduce applies a custom reducer to every window. The reducer receives a vector with non-NA elements of the window
+// and has to return a vector with a single element. If the reducer returns a vector of another length, the result for
+// the element is NA.
+func (r *Rolling) Reduce(fn func(Vector) Vector) Vector {
+	return r.reduce(fn, "_rolling")
+}
+
+func (r *Rolling) reduce(fn func(Vector) Vector, columnPostfix string) Vector {
+	results := make([]Vector, r.vec.length)
+	var typed Vector
+
+	r.traverse(func(pos int, indices []int) {
+		result := NA(1)
+		if indices != nil {
+			if res := fn(r.vec.ByIndices(indices)); res != nil && res.Len() == 1 {
+				result = res
+			}
+		}
+
+		if typed == nil {
+			if _, ok := result.Payload().(*naPayload); !ok {
+				typed = result
+			}
+		}
+
+		results[pos] = result
+	})
+
+	if typed == nil {
+		return NA(r.vec.length).SetName(r.vec.Name() + columnPostfix)
+	}
+
+	return Combine(append([]Vector{typed.Adjust(0)}, results...)...).SetName(r.vec.Name() + columnPostfix)
+}
+
+// traverse calls fn for every element of the vector with zero-based position of the element and indices (starting
+// from one) of non-NA elements of its window. If there are not enough non-NA elements, indices are nil.
+func (r *Rolling) traverse(fn func(pos int, indices []int)) {
+	isNA := r.vec.IsNA()
+	indices := []int{}
+
+	for _, group := range r.vec.windowGroups() {
+		for i, idx := range group {
+			if r.window <= 0 {
+				fn(idx-1, nil)
+				continue
+			}
+
+			from, to := r.bounds(i, len(group))
+
+			indices = indices[:0]
+			for j := from; j < to; j++ {
+				if !isNA[group[j]-1] {
+					indices = append(indices, group[j])
+				}
+			}
+
+			if len(indices) < r.minPeriods {
+				fn(idx-1, nil)
+			} else {
+				fn(idx-1, indices)
+			}
+		}
+	}
+}
+
+// bounds returns the window [from, to) for the element at position i of a group with the given length.
+func (r *Rolling) bounds(i int, length int) (int, int) {
+	var from int
+
+	switch r.align {
+	case RollingAlignLeft:
+		from = i
+	case RollingAlignCenter:
+		from = i - r.window/2
+	default:
+		from = i - r.window + 1
+	}
+
+	to := from + r.window
+
+	if from < 0 {
+		from = 0
+	}
+
+	if to > length {
+		to = length
+	}
+
+	return from, to
+}
+
+func rollingApply[T calculable, R any](r *Rolling, data []T, aggFn func([]T) R) ([]R, []bool) {
+	outData := make([]R, len(data))
+	outNA := make([]bool, len(data))
+	values := []T{}
+
+	r.traverse(func(pos int, indices []int) {
+		if indices == nil {
+			outNA[pos] = true
+			return
+		}
+
+		values = values[:0]
+		for _, idx := range indices {
+			values = append(values, data[idx-1])
+		}
+
+		outData[pos] = aggFn(values)
+	})
+
+	return outData, outNA
+}
+
+func genRollingSum[T calculable](values []T) T {
+	var sum T
+	for _, val := range values {
+		sum += val
+	}
+
+	return sum
+}
+
+func genRollingMean[T calculable](values []T) float64 {
+	return float64(genRollingSum(values)) / float64(len(values))
+}
+
+func genRollingMin[T constraints.Ordered](values []T) T {
+	min := values[0]
+	for _, val := range values[1:] {
+		if val < min {
+			min = val
+		}
+	}
+
+	return min
+}
+
+func genRollingMax[T constraints.Ordered](values []T) T {
+	max := values[0]
+	for _, val := range values[1:] {
+		if val > max {
+			max = val
+		}
+	}
+
+	return max
+}
+
+func genRollingMedian[T calculable](values []T) T {
+	sorted := make([]T, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	length := len(sorted)
+	if length%2 == 0 {
+		return (sorted[length/2-1] + sorted[length/2]) / 2
+	}
+
+	return sorted[length/2]
+}
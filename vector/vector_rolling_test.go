@@ -0,0 +1,118 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRolling_Aggregations(t *testing.T) {
+	vec := IntegerWithNA([]int{1, 2, 3, 0, 5, 6}, []bool{false, false, false, true, false, false})
+
+	testData := []struct {
+		name     string
+		fn       func(*Rolling) Vector
+		rolling  *Rolling
+		expected Vector
+	}{
+		{
+			name:    "sum right",
+			fn:      (*Rolling).Sum,
+			rolling: vec.Rolling(2),
+			expected: IntegerWithNA([]int{0, 3, 5, 0, 0, 11},
+				[]bool{true, false, false, true, true, false}),
+		},
+		{
+			name:     "sum min periods",
+			fn:       (*Rolling).Sum,
+			rolling:  vec.Rolling(2, OptionRollingMinPeriods(1)),
+			expected: Integer([]int{1, 3, 5, 3, 5, 11}),
+		},
+		{
+			name:    "sum left",
+			fn:      (*Rolling).Sum,
+			rolling: vec.Rolling(2, OptionRollingAlign(RollingAlignLeft)),
+			expected: IntegerWithNA([]int{3, 5, 0, 0, 11, 0},
+				[]bool{false, false, true, true, false, true}),
+		},
+		{
+			name:     "mean center",
+			fn:       (*Rolling).Mean,
+			rolling:  vec.Rolling(3, OptionRollingAlign(RollingAlignCenter), OptionRollingMinPeriods(2)),
+			expected: Float([]float64{1.5, 2, 2.5, 4, 5.5, 5.5}),
+		},
+		{
+			name:    "min",
+			fn:      (*Rolling).Min,
+			rolling: Float([]float64{3, 1, 2, 5, 4}).Rolling(3),
+			expected: FloatWithNA([]float64{0, 0, 1, 1, 2},
+				[]bool{true, true, false, false, false}),
+		},
+		{
+			name:    "max",
+			fn:      (*Rolling).Max,
+			rolling: Float([]float64{3, 1, 2, 5, 4}).Rolling(3),
+			expected: FloatWithNA([]float64{0, 0, 3, 5, 5},
+				[]bool{true, true, false, false, false}),
+		},
+		{
+			name:    "median",
+			fn:      (*Rolling).Median,
+			rolling: Float([]float64{3, 1, 2, 5, 4}).Rolling(3),
+			expected: FloatWithNA([]float64{0, 0, 2, 2, 4},
+				[]bool{true, true, false, false, false}),
+		},
+		{
+			name: "grouped",
+			fn:   (*Rolling).Sum,
+			rolling: Integer([]int{1, 10, 2, 20, 3, 30}).
+				GroupByIndices([][]int{{1, 3, 5}, {2, 4, 6}}).Rolling(2),
+			expected: IntegerWithNA([]int{0, 0, 3, 30, 5, 50},
+				[]bool{true, true, false, false, false, false}),
+		},
+		{
+			name:     "string max",
+			fn:       (*Rolling).Max,
+			rolling:  String([]string{"b", "a", "c"}).Rolling(2, OptionRollingMinPeriods(1)),
+			expected: String([]string{"b", "b", "c"}),
+		},
+		{
+			name:     "not supported",
+			fn:       (*Rolling).Mean,
+			rolling:  String([]string{"b", "a", "c"}).Rolling(2),
+			expected: NA(3),
+		},
+		{
+			name:     "zero window",
+			fn:       (*Rolling).Sum,
+			rolling:  Integer([]int{1, 2}).Rolling(0),
+			expected: IntegerWithNA([]int{0, 0}, []bool{true, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.fn(data.rolling)
+
+			if !CompareVectorsForTest(result, data.expected) {
+				t.Error(fmt.Sprintf("Result (%v) does not match expected (%v)", result, data.expected))
+			}
+		})
+	}
+}
+
+func TestRolling_Reduce(t *testing.T) {
+	vec := IntegerWithNA([]int{1, 2, 0, 4}, []bool{false, false, true, false}, OptionVectorName("x"))
+
+	result := vec.Rolling(2, OptionRollingMinPeriods(1)).Reduce(func(window Vector) Vector {
+		return Integer([]int{window.Len()})
+	})
+	expected := Integer([]int{1, 2, 1, 1})
+
+	if !CompareVectorsForTest(result, expected) {
+		t.Error(fmt.Sprintf("Result (%v) does not match expected (%v)", result, expected))
+	}
+
+	if result.Name() != "x_rolling" {
+		t.Error(fmt.Sprintf("Name (%v) does not match expected (%v)", result.Name(), "x_rolling"))
+	}
+}
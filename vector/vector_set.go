@@ -0,0 +1,160 @@
+package vector
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// nanKey is used as a set key for NaN which is not equal to itself.
+type nanKey struct{}
+
+// elementKey is a set key of an element. The kind is the type of the element, so values of different types,
+// which are converted to the same key value (like times to integers), are not equal.
+type elementKey struct {
+	kind string
+	val  any
+}
+
+// combineCommon concatenates vectors converting them to a common type in the same way as IfElse does.
+// The result has the name of the first vector.
+func combineCommon(vectors ...Vector) Vector {
+	if len(vectors) == 0 {
+		return NA(0)
+	}
+
+	coerced := coerceToCommonType(vectors...)
+
+	typed := coerced[0]
+	for _, vec := range coerced {
+		if vec.Type() != PayloadTypeNA {
+			typed = vec
+			break
+		}
+	}
+
+	first := coerced[0]
+	if first.Type() == PayloadTypeNA && typed.Type() != PayloadTypeNA {
+		first = typed.ByIndices(make([]int, first.Len())).SetName(vectors[0].Name())
+	}
+
+	return first.Append(coerced[1:]...)
+}
+
+// Union returns unique elements of both vectors in the order of their first appearance. The vectors are
+// converted to a common type in the same way as IfElse does. NA-values are equal to each other.
+func Union(x, y Vector) Vector {
+	return setOperation(x, y, func(inX, inY bool) bool {
+		return true
+	})
+}
+
+// Intersect returns unique elements of the first vector which are present in the second one. The vectors are
+// converted to a common type in the same way as IfElse does. NA-values are equal to each other.
+func Intersect(x, y Vector) Vector {
+	return setOperation(x, y, func(inX, inY bool) bool {
+		return inX && inY
+	})
+}
+
+// SetDiff returns unique elements of the first vector which are not present in the second one. The vectors are
+// converted to a common type in the same way as IfElse does. NA-values are equal to each other.
+func SetDiff(x, y Vector) Vector {
+	return setOperation(x, y, func(inX, inY bool) bool {
+		return inX && !inY
+	})
+}
+
+// SymDiff returns unique elements which are present in only one of the vectors: elements of the first vector
+// go first. The vectors are converted to a common type in the same way as IfElse does. NA-values are equal to
+// each other.
+func SymDiff(x, y Vector) Vector {
+	return setOperation(x, y, func(inX, inY bool) bool {
+		return inX != inY
+	})
+}
+
+// IsIn returns a boolean slice where true means the corresponding element of the vector is present in the other
+// vector. The vectors are compared in the same way as Union does, so an NA-element is in a vector with NA-values.
+func (v *vector) IsIn(other Vector) []bool {
+	combined := combineCommon(v, other)
+	keys := ElementKeys(combined)
+
+	otherKeys := make(map[any]struct{}, other.Len())
+	for _, key := range keys[v.length:] {
+		otherKeys[key] = struct{}{}
+	}
+
+	booleans := make([]bool, v.length)
+	for i, key := range keys[:v.length] {
+		_, booleans[i] = otherKeys[key]
+	}
+
+	return booleans
+}
+
+// setOperation selects first appearances of values of combined vectors for which the selector returns true.
+// The selector receives flags showing whether the value is present in each of the vectors.
+func setOperation(x, y Vector, selector func(inX, inY bool) bool) Vector {
+	combined := combineCommon(x, y)
+	keys := ElementKeys(combined)
+
+	first := map[any]int{}
+	inX := map[any]bool{}
+	inY := map[any]bool{}
+	order := []any{}
+	for i, key := range keys {
+		if _, ok := first[key]; !ok {
+			first[key] = i + 1
+			order = append(order, key)
+		}
+		if i < x.Len() {
+			inX[key] = true
+		} else {
+			inY[key] = true
+		}
+	}
+
+	indices := []int{}
+	for _, key := range order {
+		if selector(inX[key], inY[key]) {
+			indices = append(indices, first[key])
+		}
+	}
+
+	return combined.ByIndices(indices)
+}
+
+// ElementKeys returns hashable keys for elements of the vector which are equal for equal values, so they can be
+// used as map keys in set operations. Keys hold the type of the element, so elements of different types are never
+// equal. NaN-values have the same key and NA-elements have nil keys.
+func ElementKeys(vec Vector) []any {
+	data := vec.Data()
+	keys := make([]any, len(data))
+
+	for i, val := range data {
+		if val == nil {
+			continue
+		}
+
+		kind := reflect.TypeOf(val).String()
+		switch typed := val.(type) {
+		case float64:
+			if math.IsNaN(typed) {
+				keys[i] = elementKey{kind, nanKey{}}
+			} else {
+				keys[i] = elementKey{kind, typed}
+			}
+		case time.Time:
+			keys[i] = elementKey{kind, typed.UnixNano()}
+		default:
+			if reflect.TypeOf(val).Comparable() {
+				keys[i] = elementKey{kind, val}
+			} else {
+				keys[i] = elementKey{kind, vec.StrForElem(i + 1)}
+			}
+		}
+	}
+
+	return keys
+}
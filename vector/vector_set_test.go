@@ -0,0 +1,129 @@
+package vector
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSetOperations(t *testing.T) {
+	x := IntegerWithNA([]int{3, 1, 3, 0, 2}, []bool{false, false, false, true, false})
+	y := Float([]float64{2, 4, 2.5})
+	mixed := []any{"[1]", []int{1}, time.Unix(0, 5).UTC(), int64(5), 5, "5"}
+
+	testData := []struct {
+		name   string
+		result Vector
+		expect Vector
+	}{
+		{
+			name:   "union",
+			result: Union(x, y),
+			expect: FloatWithNA([]float64{3, 1, 0, 2, 4, 2.5}, []bool{false, false, true, false, false, false}),
+		},
+		{
+			name:   "intersect",
+			result: Intersect(x, y),
+			expect: Float([]float64{2}),
+		},
+		{
+			name:   "set difference",
+			result: SetDiff(x, y),
+			expect: FloatWithNA([]float64{3, 1, 0}, []bool{false, false, true}),
+		},
+		{
+			name:   "symmetric difference",
+			result: SymDiff(x, y),
+			expect: FloatWithNA([]float64{3, 1, 0, 4, 2.5}, []bool{false, false, true, false, false}),
+		},
+		{
+			name:   "intersect with NA",
+			result: Intersect(x, NA(1)),
+			expect: IntegerWithNA([]int{0}, []bool{true}),
+		},
+		{
+			name:   "NaN",
+			result: Union(Float([]float64{math.NaN(), 1}), Float([]float64{math.NaN()})),
+			expect: Float([]float64{math.NaN(), 1}),
+		},
+		{
+			name:   "integer and decimal",
+			result: Union(Integer([]int{1, 2}), Decimal([]int64{150, 200})),
+			expect: Decimal([]int64{100, 200, 150}),
+		},
+		{
+			name:   "mixed types union",
+			result: Union(Any(mixed), Any(mixed)),
+			expect: Any(mixed),
+		},
+		{
+			name:   "mixed types intersect",
+			result: Intersect(Any([]any{"[1]", int64(5), 5}), Any([]any{[]int{1}, time.Unix(0, 5).UTC(), "5"})),
+			expect: Any([]any{}),
+		},
+		{
+			name:   "strings and factor",
+			result: SetDiff(String([]string{"a", "b", "c"}), String([]string{"b"}).AsFactor()),
+			expect: String([]string{"a", "c"}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if !CompareVectorsForTest(data.result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", data.result, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_IsIn(t *testing.T) {
+	testData := []struct {
+		name   string
+		vec    Vector
+		other  Vector
+		expect []bool
+	}{
+		{
+			name:   "integers",
+			vec:    Integer([]int{1, 2, 3, 4}),
+			other:  Integer([]int{4, 2}),
+			expect: []bool{false, true, false, true},
+		},
+		{
+			name:   "integer and float",
+			vec:    Integer([]int{1, 2}),
+			other:  Float([]float64{1.5, 2}),
+			expect: []bool{false, true},
+		},
+		{
+			name:   "with NA",
+			vec:    StringWithNA([]string{"a", ""}, []bool{false, true}),
+			other:  StringWithNA([]string{"b", ""}, []bool{false, true}),
+			expect: []bool{false, true},
+		},
+		{
+			name:   "mixed types",
+			vec:    Any([]any{"[1]", int64(5), 5, []int{1}}),
+			other:  Any([]any{[]int{1}, time.Unix(0, 5).UTC(), "5"}),
+			expect: []bool{false, false, false, true},
+		},
+		{
+			name:   "empty",
+			vec:    String([]string{"a"}),
+			other:  String([]string{}),
+			expect: []bool{false},
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.vec.IsIn(data.other)
+			if !reflect.DeepEqual(result, data.expect) {
+				t.Error(fmt.Sprintf("Result (%v) is not equal to expected (%v)", result, data.expect))
+			}
+		})
+	}
+}
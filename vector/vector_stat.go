@@ -1,26 +1,34 @@
 package vector
 
+// invokeGroupFunction calculates the aggregating function for the vector or for every group of a grouped vector.
+// The result is named as the vector with the postfix of the function in both cases.
 func invokeGroupFunction(
 	v *vector,
 	checkFn func(*vector) bool,
 	actionFn func(*vector) Payload,
 	columnPostfix string,
+	options []Option,
 ) Vector {
 	if v.IsGrouped() {
 		vectors := v.GroupVectors()
 		outValues := make([]Vector, len(vectors))
 		for i := 0; i < len(vectors); i++ {
-			outValues[i] = invokeGroupFunction(vectors[i].(*vector), checkFn, actionFn, columnPostfix)
+			outValues[i] = invokeGroupFunction(vectors[i].(*vector), checkFn, actionFn, columnPostfix, options)
 		}
 
 		return Combine(outValues...).SetName(v.Name() + columnPostfix)
 	}
 
+	conf := MergeOptions(options)
+	if conf.HasOption(keyOptionNARemove) && conf.Value(keyOptionNARemove).(bool) {
+		v = v.withoutNA()
+	}
+
 	vec := NA(1)
 	if checkFn(v) {
 		vec = New(actionFn(v), v.Options()...)
 	}
-	vec.SetName(v.Name() + "_sum")
+	vec.SetName(v.Name() + columnPostfix)
 
 	return vec
 }
@@ -51,13 +59,29 @@ func invokeFunction(
 
 }
 
+// Statistics interface contains aggregating and cumulative statistical functions. Aggregating functions are
+// calculated for every group of a grouped vector and accept OptionNARemove(true) to skip NA-values instead of
+// returning NA.
 type Statistics interface {
-	Sum() Vector
-	Max() Vector
-	Min() Vector
-	Mean() Vector
-	Median() Vector
-	Prod() Vector
+	Sum(options ...Option) Vector
+	Max(options ...Option) Vector
+	Min(options ...Option) Vector
+	Mean(options ...Option) Vector
+	Median(options ...Option) Vector
+	Prod(options ...Option) Vector
+	Var(options ...Option) Vector
+	Sd(options ...Option) Vector
+	Quantile(probs []float64, options ...Option) Vector
+	IQR(options ...Option) Vector
+	Mad(options ...Option) Vector
+	Mode(options ...Option) Vector
+	Skewness(options ...Option) Vector
+	Kurtosis(options ...Option) Vector
+	N(options ...Option) Vector
+	NDistinct(options ...Option) Vector
+	First(options ...Option) Vector
+	Last(options ...Option) Vector
+	Nth(n int, options ...Option) Vector
 	CumSum() Vector
 	CumProd() Vector
 	CumMax() Vector
@@ -70,17 +94,18 @@ type Summer interface {
 }
 
 // Sum returns the sum of the vector.
-func (v *vector) Sum() Vector {
+func (v *vector) Sum(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Summer)
+			_, ok := v.Payload().(Summer)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Summer).Sum()
+			return v.Payload().(Summer).Sum()
 		},
 		"_sum",
+		options,
 	)
 }
 
@@ -90,17 +115,18 @@ type Proder interface {
 }
 
 // Prod returns the product of the vector.
-func (v *vector) Prod() Vector {
+func (v *vector) Prod(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Proder)
+			_, ok := v.Payload().(Proder)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Proder).Prod()
+			return v.Payload().(Proder).Prod()
 		},
 		"_prod",
+		options,
 	)
 }
 
@@ -110,17 +136,18 @@ type Maxxer interface {
 }
 
 // Max returns the maximum of the vector.
-func (v *vector) Max() Vector {
+func (v *vector) Max(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Maxxer)
+			_, ok := v.Payload().(Maxxer)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Maxxer).Max()
+			return v.Payload().(Maxxer).Max()
 		},
 		"_max",
+		options,
 	)
 }
 
@@ -130,17 +157,18 @@ type Minner interface {
 }
 
 // Min returns the minimum of the vector.
-func (v *vector) Min() Vector {
+func (v *vector) Min(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Minner)
+			_, ok := v.Payload().(Minner)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Minner).Min()
+			return v.Payload().(Minner).Min()
 		},
 		"_min",
+		options,
 	)
 }
 
@@ -150,17 +178,18 @@ type Meaner interface {
 }
 
 // Mean returns the mean of the vector.
-func (v *vector) Mean() Vector {
+func (v *vector) Mean(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Meaner)
+			_, ok := v.Payload().(Meaner)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Meaner).Mean()
+			return v.Payload().(Meaner).Mean()
 		},
 		"_mean",
+		options,
 	)
 }
 
@@ -170,17 +199,18 @@ type Medianer interface {
 }
 
 // Median returns the median of the vector.
-func (v *vector) Median() Vector {
+func (v *vector) Median(options ...Option) Vector {
 	return invokeGroupFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(Medianer)
+			_, ok := v.Payload().(Medianer)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(Medianer).Median()
+			return v.Payload().(Medianer).Median()
 		},
 		"_median",
+		options,
 	)
 }
 
@@ -195,11 +225,11 @@ func (v *vector) CumSum() Vector {
 	return invokeFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(CumSummer)
+			_, ok := v.Payload().(CumSummer)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(CumSummer).CumSum()
+			return v.Payload().(CumSummer).CumSum()
 		},
 		"_cumsum",
 	)
@@ -216,11 +246,11 @@ func (v *vector) CumProd() Vector {
 	return invokeFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(CumProder)
+			_, ok := v.Payload().(CumProder)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(CumProder).CumProd()
+			return v.Payload().(CumProder).CumProd()
 		},
 		"_cumprod",
 	)
@@ -237,11 +267,11 @@ func (v *vector) CumMax() Vector {
 	return invokeFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(CumMaxer)
+			_, ok := v.Payload().(CumMaxer)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(CumMaxer).CumMax()
+			return v.Payload().(CumMaxer).CumMax()
 		},
 		"_cummax",
 	)
@@ -258,12 +288,282 @@ func (v *vector) CumMin() Vector {
 	return invokeFunction(
 		v,
 		func(v *vector) bool {
-			_, ok := v.payload.(CumMinner)
+			_, ok := v.Payload().(CumMinner)
 			return ok
 		},
 		func(v *vector) Payload {
-			return v.payload.(CumMinner).CumMin()
+			return v.Payload().(CumMinner).CumMin()
 		},
 		"_cummin",
 	)
 }
+
+// Varer has to be implemented by the payload to be able to calculate the sample variance of it.
+type Varer interface {
+	Var() Payload
+}
+
+// Var returns the sample variance of the vector.
+func (v *vector) Var(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Varer)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Varer).Var()
+		},
+		"_var",
+		options,
+	)
+}
+
+// Sder has to be implemented by the payload to be able to calculate the sample standard deviation of it.
+type Sder interface {
+	Sd() Payload
+}
+
+// Sd returns the sample standard deviation of the vector.
+func (v *vector) Sd(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Sder)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Sder).Sd()
+		},
+		"_sd",
+		options,
+	)
+}
+
+// Quantiler has to be implemented by the payload to be able to calculate quantiles of it.
+type Quantiler interface {
+	Quantile(probs []float64) Payload
+}
+
+// Quantile returns quantiles of the vector for the given probabilities (the type 7 definition from R is used).
+// The result has as many elements as probabilities for every group.
+func (v *vector) Quantile(probs []float64, options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Quantiler)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Quantiler).Quantile(probs)
+		},
+		"_quantile",
+		options,
+	)
+}
+
+// IQRer has to be implemented by the payload to be able to calculate the interquartile range of it.
+type IQRer interface {
+	IQR() Payload
+}
+
+// IQR returns the interquartile range of the vector.
+func (v *vector) IQR(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(IQRer)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(IQRer).IQR()
+		},
+		"_iqr",
+		options,
+	)
+}
+
+// Mader has to be implemented by the payload to be able to calculate the median absolute deviation of it.
+type Mader interface {
+	Mad() Payload
+}
+
+// Mad returns the median absolute deviation of the vector scaled by 1.4826 for consistency with the standard
+// deviation of normally distributed data.
+func (v *vector) Mad(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Mader)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Mader).Mad()
+		},
+		"_mad",
+		options,
+	)
+}
+
+// Skewnesser has to be implemented by the payload to be able to calculate the skewness of it.
+type Skewnesser interface {
+	Skewness() Payload
+}
+
+// Skewness returns the sample skewness (the third standardized moment) of the vector.
+func (v *vector) Skewness(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Skewnesser)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Skewnesser).Skewness()
+		},
+		"_skewness",
+		options,
+	)
+}
+
+// Kurtosiser has to be implemented by the payload to be able to calculate the kurtosis of it.
+type Kurtosiser interface {
+	Kurtosis() Payload
+}
+
+// Kurtosis returns the sample excess kurtosis (the fourth standardized moment minus 3) of the vector.
+func (v *vector) Kurtosis(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Kurtosiser)
+			return ok
+		},
+		func(v *vector) Payload {
+			return v.Payload().(Kurtosiser).Kurtosis()
+		},
+		"_kurtosis",
+		options,
+	)
+}
+
+// Mode returns the most frequent value of the vector. If there are several such values, the first occurred one
+// is returned. Works for payloads implementing Grouper interface.
+func (v *vector) Mode(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Grouper)
+			return ok
+		},
+		func(v *vector) Payload {
+			if v.length == 0 || v.HasNA() {
+				return v.Payload().ByIndices([]int{0})
+			}
+
+			groups, _ := v.Payload().(Grouper).Groups()
+			mode := groups[0]
+			for _, group := range groups[1:] {
+				if len(group) > len(mode) || len(group) == len(mode) && group[0] < mode[0] {
+					mode = group
+				}
+			}
+
+			return v.Payload().ByIndices([]int{mode[0]})
+		},
+		"_mode",
+		options,
+	)
+}
+
+// N returns the number of elements of the vector.
+func (v *vector) N(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			return true
+		},
+		func(v *vector) Payload {
+			return IntegerPayload([]int{v.length}, nil)
+		},
+		"_n",
+		options,
+	)
+}
+
+// NDistinct returns the number of distinct values of the vector. NA is counted as a distinct value.
+// Works for payloads implementing Grouper interface.
+func (v *vector) NDistinct(options ...Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			_, ok := v.Payload().(Grouper)
+			return ok
+		},
+		func(v *vector) Payload {
+			if v.length == 0 {
+				return IntegerPayload([]int{0}, nil)
+			}
+
+			groups, _ := v.Payload().(Grouper).Groups()
+
+			return IntegerPayload([]int{len(groups)}, nil)
+		},
+		"_n_distinct",
+		options,
+	)
+}
+
+// First returns the first element of the vector.
+func (v *vector) First(options ...Option) Vector {
+	return v.nth(1, "_first", options)
+}
+
+// Last returns the last element of the vector.
+func (v *vector) Last(options ...Option) Vector {
+	return v.nth(-1, "_last", options)
+}
+
+// Nth returns the n-th element of the vector. Negative n counts elements from the end (-1 is the last one).
+// If there is no such element, NA is returned.
+func (v *vector) Nth(n int, options ...Option) Vector {
+	return v.nth(n, "_nth", options)
+}
+
+func (v *vector) nth(n int, columnPostfix string, options []Option) Vector {
+	return invokeGroupFunction(
+		v,
+		func(v *vector) bool {
+			return true
+		},
+		func(v *vector) Payload {
+			idx := n
+			if n < 0 {
+				idx = v.length + n + 1
+			}
+
+			if idx < 1 || idx > v.length {
+				idx = 0
+			}
+
+			return v.Payload().ByIndices([]int{idx})
+		},
+		columnPostfix,
+		options,
+	)
+}
+
+func (v *vector) withoutNA() *vector {
+	if !v.HasNA() {
+		return v
+	}
+
+	indices := []int{}
+	for i, isNA := range v.IsNA() {
+		if !isNA {
+			indices = append(indices, i+1)
+		}
+	}
+
+	return New(v.Payload().ByIndices(indices), v.Options()...).(*vector)
+}
@@ -2,6 +2,7 @@ package vector
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -404,3 +405,230 @@ func TestVector_CumMin(t *testing.T) {
 		})
 	}
 }
+
+func TestVector_AdditionalStatistics(t *testing.T) {
+	vec := Integer([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	vecWithNA := IntegerWithNA([]int{1, 2, 0, 3}, []bool{false, false, true, false})
+	grouped := Float([]float64{1, 10, 3, 20, 5}).GroupByIndices([][]int{{1, 3, 5}, {2, 4}})
+
+	testData := []struct {
+		name     string
+		fn       func(Vector) Vector
+		vec      Vector
+		expected Vector
+	}{
+		{
+			name:     "var",
+			fn:       func(vec Vector) Vector { return vec.Var() },
+			vec:      vec,
+			expected: Float([]float64{32.0 / 7}),
+		},
+		{
+			name:     "var of one element",
+			fn:       func(vec Vector) Vector { return vec.Var() },
+			vec:      Integer([]int{1}),
+			expected: FloatWithNA([]float64{0}, []bool{true}),
+		},
+		{
+			name:     "sd",
+			fn:       func(vec Vector) Vector { return vec.Sd() },
+			vec:      vec,
+			expected: Float([]float64{math.Sqrt(32.0 / 7)}),
+		},
+		{
+			name:     "sd grouped",
+			fn:       func(vec Vector) Vector { return vec.Sd() },
+			vec:      grouped,
+			expected: Float([]float64{2, math.Sqrt(50)}),
+		},
+		{
+			name:     "quantile",
+			fn:       func(vec Vector) Vector { return vec.Quantile([]float64{0, 0.25, 0.5, 0.75, 1, 2}) },
+			vec:      vec,
+			expected: FloatWithNA([]float64{2, 4, 4.5, 5.5, 9, 0}, []bool{false, false, false, false, false, true}),
+		},
+		{
+			name:     "quantile grouped",
+			fn:       func(vec Vector) Vector { return vec.Quantile([]float64{0.5, 1}) },
+			vec:      grouped,
+			expected: Float([]float64{3, 5, 15, 20}),
+		},
+		{
+			name:     "iqr",
+			fn:       func(vec Vector) Vector { return vec.IQR() },
+			vec:      vec,
+			expected: Float([]float64{1.5}),
+		},
+		{
+			name:     "mad",
+			fn:       func(vec Vector) Vector { return vec.Mad() },
+			vec:      vec,
+			expected: Float([]float64{1.4826 * 0.5}),
+		},
+		{
+			name:     "skewness",
+			fn:       func(vec Vector) Vector { return vec.Skewness() },
+			vec:      vec,
+			expected: Float([]float64{0.65625}),
+		},
+		{
+			name:     "kurtosis",
+			fn:       func(vec Vector) Vector { return vec.Kurtosis() },
+			vec:      vec,
+			expected: Float([]float64{-0.21875}),
+		},
+		{
+			name:     "kurtosis of constant",
+			fn:       func(vec Vector) Vector { return vec.Kurtosis() },
+			vec:      Float([]float64{1, 1, 1}),
+			expected: FloatWithNA([]float64{0}, []bool{true}),
+		},
+		{
+			name:     "mode",
+			fn:       func(vec Vector) Vector { return vec.Mode() },
+			vec:      String([]string{"c", "a", "b", "b", "a"}),
+			expected: String([]string{"a"}),
+		},
+		{
+			name:     "mode with na",
+			fn:       func(vec Vector) Vector { return vec.Mode() },
+			vec:      vecWithNA,
+			expected: IntegerWithNA([]int{0}, []bool{true}),
+		},
+		{
+			name:     "mode with na removed",
+			fn:       func(vec Vector) Vector { return vec.Mode(OptionNARemove(true)) },
+			vec:      vecWithNA,
+			expected: Integer([]int{1}),
+		},
+		{
+			name:     "n",
+			fn:       func(vec Vector) Vector { return vec.N() },
+			vec:      vecWithNA,
+			expected: Integer([]int{4}),
+		},
+		{
+			name:     "n with na removed",
+			fn:       func(vec Vector) Vector { return vec.N(OptionNARemove(true)) },
+			vec:      vecWithNA,
+			expected: Integer([]int{3}),
+		},
+		{
+			name:     "n grouped",
+			fn:       func(vec Vector) Vector { return vec.N() },
+			vec:      grouped,
+			expected: Integer([]int{3, 2}),
+		},
+		{
+			name:     "n distinct",
+			fn:       func(vec Vector) Vector { return vec.NDistinct() },
+			vec:      IntegerWithNA([]int{1, 1, 0, 2}, []bool{false, false, true, false}),
+			expected: Integer([]int{3}),
+		},
+		{
+			name: "n distinct with na removed",
+			fn:   func(vec Vector) Vector { return vec.NDistinct(OptionNARemove(true)) },
+			vec: IntegerWithNA([]int{1, 1, 0, 2},
+				[]bool{false, false, true, false}),
+			expected: Integer([]int{2}),
+		},
+		{
+			name:     "first",
+			fn:       func(vec Vector) Vector { return vec.First() },
+			vec:      grouped,
+			expected: Float([]float64{1, 10}),
+		},
+		{
+			name:     "last",
+			fn:       func(vec Vector) Vector { return vec.Last() },
+			vec:      grouped,
+			expected: Float([]float64{5, 20}),
+		},
+		{
+			name:     "nth",
+			fn:       func(vec Vector) Vector { return vec.Nth(2) },
+			vec:      vec,
+			expected: Integer([]int{4}),
+		},
+		{
+			name:     "nth from end",
+			fn:       func(vec Vector) Vector { return vec.Nth(-2) },
+			vec:      vec,
+			expected: Integer([]int{7}),
+		},
+		{
+			name:     "nth out of range",
+			fn:       func(vec Vector) Vector { return vec.Nth(10) },
+			vec:      vec,
+			expected: IntegerWithNA([]int{0}, []bool{true}),
+		},
+		{
+			name:     "last with na removed",
+			fn:       func(vec Vector) Vector { return vec.Last(OptionNARemove(true)) },
+			vec:      IntegerWithNA([]int{1, 2, 0}, []bool{false, false, true}),
+			expected: Integer([]int{2}),
+		},
+		{
+			name:     "mean with na",
+			fn:       func(vec Vector) Vector { return vec.Mean() },
+			vec:      vecWithNA,
+			expected: FloatWithNA([]float64{0}, []bool{true}),
+		},
+		{
+			name:     "mean with na removed",
+			fn:       func(vec Vector) Vector { return vec.Mean(OptionNARemove(true)) },
+			vec:      vecWithNA,
+			expected: Float([]float64{2}),
+		},
+		{
+			name:     "non-numeric var",
+			fn:       func(vec Vector) Vector { return vec.Var() },
+			vec:      String([]string{"a", "b"}),
+			expected: NA(1),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result := data.fn(data.vec)
+
+			if !CompareVectorsForTest(result, data.expected) {
+				t.Error(fmt.Sprintf("Result (%v) does not match expected (%v)", result, data.expected))
+			}
+		})
+	}
+}
+
+func TestVector_StatisticsNames(t *testing.T) {
+	vec := Integer([]int{1, 2, 3}, OptionVectorName("x"))
+	grouped := vec.GroupByIndices([][]int{{1, 2}, {3}})
+
+	testData := []struct {
+		name     string
+		result   Vector
+		expected string
+	}{
+		{"sum", vec.Sum(), "x_sum"},
+		{"prod", vec.Prod(), "x_prod"},
+		{"max", vec.Max(), "x_max"},
+		{"min", vec.Min(), "x_min"},
+		{"mean", vec.Mean(), "x_mean"},
+		{"median", vec.Median(), "x_median"},
+		{"sd", vec.Sd(), "x_sd"},
+		{"grouped sum", grouped.Sum(), "x_sum"},
+		{"grouped prod", grouped.Prod(), "x_prod"},
+		{"grouped max", grouped.Max(), "x_max"},
+		{"grouped min", grouped.Min(), "x_min"},
+		{"grouped mean", grouped.Mean(), "x_mean"},
+		{"grouped median", grouped.Median(), "x_median"},
+		{"n distinct", vec.NDistinct(), "x_n_distinct"},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if data.result.Name() != data.expected {
+				t.Error(fmt.Sprintf("Name (%v) does not match expected (%v)", data.result.Name(), data.expected))
+			}
+		})
+	}
+}
@@ -294,8 +294,8 @@ func TestVector_AsBoolean(t *testing.T) {
 				if !reflect.DeepEqual(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -341,8 +341,8 @@ func TestVector_AsInteger(t *testing.T) {
 				if !reflect.DeepEqual(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -388,8 +388,8 @@ func TestVector_AsFloat(t *testing.T) {
 				if !util.EqualFloatArrays(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -435,8 +435,8 @@ func TestVector_AsComplex(t *testing.T) {
 				if !util.EqualComplexArrays(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -476,8 +476,8 @@ func TestVector_AsString(t *testing.T) {
 				if !reflect.DeepEqual(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -517,8 +517,8 @@ func TestVector_AsInterface(t *testing.T) {
 				if !reflect.DeepEqual(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -565,8 +565,8 @@ func TestVector_AsTime(t *testing.T) {
 				if !reflect.DeepEqual(payload.data, data.outValues) {
 					t.Error(fmt.Sprintf("Payload data (%v) is not equal to expected (%v)", payload.data, data.outValues))
 				}
-				if !reflect.DeepEqual(payload.na, data.outNA) {
-					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.na, data.outNA))
+				if !reflect.DeepEqual(payload.IsNA(), data.outNA) {
+					t.Error(fmt.Sprintf("Payload NA (%v) is not equal to expected (%v)", payload.IsNA(), data.outNA))
 				}
 			}
 		})
@@ -989,15 +989,15 @@ func TestVector_Clone(t *testing.T) {
 		t.Error(fmt.Sprintf("vec.length (%d) is not equal to newVec.length (%d)", vec.length, newVec.length))
 	}
 
-	srcAddr := &(vec.payload.(*integerPayload).data[0])
-	newAddr := &(newVec.payload.(*integerPayload).data[0])
+	srcAddr := &(vec.Payload().(*integerPayload).data[0])
+	newAddr := &(newVec.Payload().(*integerPayload).data[0])
 
 	if srcAddr != newAddr {
 		t.Error("Payload data was not cloned")
 	}
 
-	srcAddrNA := &(vec.payload.(*integerPayload).na[0])
-	newAddrNA := &(newVec.payload.(*integerPayload).na[0])
+	srcAddrNA := &(vec.Payload().(*integerPayload).na[0])
+	newAddrNA := &(newVec.Payload().(*integerPayload).na[0])
 
 	if srcAddrNA != newAddrNA {
 		t.Error("Payload NA data was not cloned")
@@ -1265,7 +1265,7 @@ func TestVector_Append(t *testing.T) {
 	for _, data := range testData {
 		t.Run(data.name, func(t *testing.T) {
 			outVector := vec.Append(data.vecs...).(*vector)
-			outPayload := outVector.payload.(*integerPayload)
+			outPayload := outVector.Payload().(*integerPayload)
 
 			length := vec.Len()
 			for _, v := range data.vecs {
@@ -1280,9 +1280,9 @@ func TestVector_Append(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
@@ -1325,9 +1325,9 @@ func TestVector_Adjust(t *testing.T) {
 				t.Error(fmt.Sprintf("Output data (%v) does not match expected (%v)",
 					outPayload.data, data.outData))
 			}
-			if !reflect.DeepEqual(data.outNA, outPayload.na) {
+			if !reflect.DeepEqual(data.outNA, outPayload.IsNA()) {
 				t.Error(fmt.Sprintf("Output NA (%v) does not match expected (%v)",
-					outPayload.na, data.outNA))
+					outPayload.IsNA(), data.outNA))
 			}
 		})
 	}
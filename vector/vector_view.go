@@ -0,0 +1,109 @@
+package vector
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// slicer is implemented by payloads which can share their data with a payload for a contiguous range of elements.
+type slicer interface {
+	slice(from, to int) Payload
+}
+
+// payloadView is a lazy selection of elements of a source payload. Selections from a view are composed with it
+// instead of copying the data, and the resulting payload is materialized only once, when the data is read.
+type payloadView struct {
+	source Payload
+	// indices are one-based indices of the source elements where zero stands for NA. They are nil if the view
+	// is a contiguous range.
+	indices []int
+	// from and to are zero-based bounds of a contiguous range of the source elements.
+	from int
+	to   int
+
+	once         sync.Once
+	materialized atomic.Bool
+	payload      Payload
+}
+
+func newRangeView(source Payload, from, to int) *payloadView {
+	return &payloadView{
+		source: source,
+		from:   from,
+		to:     to,
+	}
+}
+
+func newIndicesView(source Payload, indices []int) *payloadView {
+	if indices == nil {
+		indices = []int{}
+	}
+
+	return &payloadView{
+		source:  source,
+		indices: indices,
+	}
+}
+
+func (pv *payloadView) len() int {
+	if pv.indices == nil {
+		return pv.to - pv.from
+	}
+
+	return len(pv.indices)
+}
+
+// materialize returns the payload for the view. A contiguous range shares the data of the source if the source
+// supports slicing.
+func (pv *payloadView) materialize() Payload {
+	pv.once.Do(func() {
+		if pv.indices != nil {
+			pv.payload = pv.source.ByIndices(pv.indices)
+		} else if sl, ok := pv.source.(slicer); ok {
+			pv.payload = sl.slice(pv.from, pv.to)
+		} else {
+			indices := make([]int, pv.to-pv.from)
+			for i := range indices {
+				indices[i] = pv.from + i + 1
+			}
+			pv.payload = pv.source.ByIndices(indices)
+		}
+		pv.materialized.Store(true)
+	})
+
+	return pv.payload
+}
+
+// byIndices composes the view with a selection of its elements by one-based indices.
+func (pv *payloadView) byIndices(indices []int) *payloadView {
+	if pv.materialized.Load() {
+		return newIndicesView(pv.payload, indices)
+	}
+
+	composed := make([]int, len(indices))
+	for i, idx := range indices {
+		switch {
+		case idx == 0:
+			composed[i] = 0
+		case pv.indices == nil:
+			composed[i] = pv.from + idx
+		default:
+			composed[i] = pv.indices[idx-1]
+		}
+	}
+
+	return newIndicesView(pv.source, composed)
+}
+
+// fromTo composes the view with a contiguous range of its elements by zero-based bounds.
+func (pv *payloadView) fromTo(from, to int) *payloadView {
+	if pv.materialized.Load() {
+		return newRangeView(pv.payload, from, to)
+	}
+
+	if pv.indices == nil {
+		return newRangeView(pv.source, pv.from+from, pv.from+to)
+	}
+
+	return newIndicesView(pv.source, pv.indices[from:to])
+}
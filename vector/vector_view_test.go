@@ -0,0 +1,153 @@
+package vector
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestVector_Views(t *testing.T) {
+	data := make([]int, 150)
+	na := make([]bool, 150)
+	for i := range data {
+		data[i] = i + 1
+		na[i] = i%7 == 3
+	}
+	vec := IntegerWithNA(data, na)
+
+	testData := []struct {
+		name   string
+		vec    Vector
+		expect Vector
+	}{
+		{
+			name:   "range",
+			vec:    vec.FromTo(60, 140),
+			expect: vec.ByIndices(incIndices(indicesArray(150))[59:140]),
+		},
+		{
+			name:   "range of range",
+			vec:    vec.FromTo(10, 140).FromTo(55, 100),
+			expect: vec.ByIndices(incIndices(indicesArray(150))[63:109]),
+		},
+		{
+			name:   "range without NA",
+			vec:    vec.FromTo(5, 10),
+			expect: Integer([]int{5, 6, 7, 8, 9, 10}),
+		},
+		{
+			name:   "indices of range",
+			vec:    vec.FromTo(100, 110).ByIndices([]int{3, 0, 1, 5}),
+			expect: IntegerWithNA([]int{0, 0, 100, 104}, []bool{true, true, false, false}),
+		},
+		{
+			name:   "range of indices",
+			vec:    vec.ByIndices([]int{150, 4, 2, 1, 11}).FromTo(2, 4),
+			expect: IntegerWithNA([]int{0, 2, 1}, []bool{true, false, false}),
+		},
+		{
+			name:   "filter of arranged range",
+			vec:    vec.FromTo(1, 12).Filter(vec.FromTo(1, 12).Gt(8)).ByIndices([]int{4, 3, 2, 1}),
+			expect: Integer([]int{12, 10, 9}),
+		},
+		{
+			name:   "empty range",
+			vec:    vec.FromTo(0, 0),
+			expect: Integer([]int{}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if data.vec.Len() != data.expect.Len() {
+				t.Error(fmt.Sprintf("Length (%d) is not equal to expected (%d)", data.vec.Len(), data.expect.Len()))
+			}
+			if !CompareVectorsForTest(data.vec, data.expect) {
+				t.Error(fmt.Sprintf("Vector (%v) is not equal to expected (%v)", data.vec, data.expect))
+			}
+		})
+	}
+}
+
+func TestVector_FromToSharesData(t *testing.T) {
+	vec := FloatWithNA([]float64{1, 2, 3, 4, 5}, []bool{false, true, false, false, false}).(*vector)
+	newVec := vec.FromTo(2, 4).FromTo(2, 3).(*vector)
+
+	if newVec.Type() != "float" {
+		t.Error(fmt.Sprintf("Type (%s) is not float", newVec.Type()))
+	}
+
+	srcAddr := &(vec.Payload().(*floatPayload).data[2])
+	newAddr := &(newVec.Payload().(*floatPayload).data[0])
+	if srcAddr != newAddr {
+		t.Error("Payload data was copied")
+	}
+
+	if newVec.Payload() != newVec.Payload() {
+		t.Error("Payload was materialized twice")
+	}
+
+	if !reflect.DeepEqual(newVec.IsNA(), []bool{false, false}) {
+		t.Error(fmt.Sprintf("NA (%v) is not equal to expected", newVec.IsNA()))
+	}
+	if newVec.HasNA() {
+		t.Error("Sliced range without NA-values has NA")
+	}
+
+	sorted := newVec.FromTo(1, 2).SortedIndices()
+	if !reflect.DeepEqual(sorted, []int{1, 2}) {
+		t.Error(fmt.Sprintf("Sorted indices (%v) are not equal to expected", sorted))
+	}
+}
+
+func TestVector_ViewsKeepOptions(t *testing.T) {
+	vec := Integer([]int{1, 2, 3, 4, 5}, OptionVectorName("x"), OptionMaxPrintElements(2))
+
+	testData := []struct {
+		name string
+		vec  Vector
+	}{
+		{"by indices", vec.ByIndices([]int{5, 1, 3})},
+		{"from to", vec.FromTo(2, 4)},
+		{"range of indices", vec.ByIndices([]int{5, 1, 3}).FromTo(1, 2)},
+		{"filter", vec.Filter(vec.Gt(1))},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			if data.vec.Name() != "x" {
+				t.Error(fmt.Sprintf("Name (%s) is not equal to expected (x)", data.vec.Name()))
+			}
+			if elements := data.vec.(*vector).options.maxPrintElements; elements != 2 {
+				t.Error(fmt.Sprintf("Max print elements (%d) are not equal to expected (2)", elements))
+			}
+		})
+	}
+}
+
+func TestNABitmap_Slice(t *testing.T) {
+	na := boolsWithNAAt(200, 3, 63, 64, 130, 199)
+
+	testData := []struct {
+		name string
+		from int
+		to   int
+	}{
+		{name: "aligned", from: 64, to: 128},
+		{name: "unaligned", from: 3, to: 131},
+		{name: "unaligned tail", from: 65, to: 200},
+		{name: "without NA", from: 4, to: 60},
+		{name: "empty", from: 10, to: 10},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			sliced := newNABitmap(na).slice(data.from, data.to)
+			expected := newNABitmap(na[data.from:data.to])
+
+			if !reflect.DeepEqual(sliced, expected) {
+				t.Error(fmt.Sprintf("Sliced bitmap (%v) is not equal to expected (%v)", sliced, expected))
+			}
+		})
+	}
+}
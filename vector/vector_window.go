@@ -0,0 +1,203 @@
+package vector
+
+import (
+	"sort"
+)
+
+// Window interface contains offset, ranking and rolling functions. Every function (except Rolling() which returns
+// a sliding window view) returns a vector of the same length as the source one. If the vector is grouped, the function
+// is applied to every group separately and the results are placed back to positions of the corresponding elements.
+type Window interface {
+	Lag(n int) Vector
+	Lead(n int) Vector
+	RowNumber() Vector
+	MinRank() Vector
+	DenseRank() Vector
+	PercentRank() Vector
+	Ntile(k int) Vector
+	Rolling(window int, options ...Option) *Rolling
+}
+
+// Lag returns the vector with elements shifted n positions forward. First n elements of the vector (or of every
+// group) become NA.
+func (v *vector) Lag(n int) Vector {
+	return v.shift(n).SetName(v.Name() + "_lag")
+}
+
+// Lead returns the vector with elements shifted n positions backward. Last n elements of the vector (or of every
+// group) become NA.
+func (v *vector) Lead(n int) Vector {
+	return v.shift(-n).SetName(v.Name() + "_lead")
+}
+
+// RowNumber returns an integer vector with the position of every element in the vector (or in its group).
+func (v *vector) RowNumber() Vector {
+	data := make([]int, v.length)
+
+	for _, group := range v.windowGroups() {
+		for i, idx := range group {
+			data[idx-1] = i + 1
+		}
+	}
+
+	return Integer(data, OptionVectorName(v.Name()+"_row_number"))
+}
+
+// MinRank returns an integer vector with ranks of the elements where tied elements get the minimal rank
+// (like 1, 2, 2, 4). NA-values get NA rank. Returns NA vector if the payload is not arrangeable.
+func (v *vector) MinRank() Vector {
+	return v.rankIntegers(func(order []int, dense []int, isNA []bool) []int {
+		ranks := make([]int, len(dense))
+
+		rank := 0
+		for i, idx := range order {
+			if i == 0 || dense[idx] != dense[order[i-1]] {
+				rank = i + 1
+			}
+			ranks[idx] = rank
+		}
+
+		return ranks
+	}, "_min_rank")
+}
+
+// DenseRank returns an integer vector with ranks of the elements where there are no gaps between ranks
+// (like 1, 2, 2, 3). NA-values get NA rank. Returns NA vector if the payload is not arrangeable.
+func (v *vector) DenseRank() Vector {
+	return v.rankIntegers(func(order []int, dense []int, isNA []bool) []int {
+		return dense
+	}, "_dense_rank")
+}
+
+// Ntile returns an integer vector which splits the vector (or every group) into k buckets of roughly equal size.
+// NA-values get NA bucket. Returns NA vector if the payload is not arrangeable.
+func (v *vector) Ntile(k int) Vector {
+	if k <= 0 {
+		return NA(v.length).SetName(v.Name() + "_ntile")
+	}
+
+	return v.rankIntegers(func(order []int, dense []int, isNA []bool) []int {
+		tiles := make([]int, len(dense))
+
+		for i, idx := range order {
+			tiles[idx] = k*i/len(order) + 1
+		}
+
+		return tiles
+	}, "_ntile")
+}
+
+// PercentRank returns a float vector with min ranks rescaled to [0, 1]. NA-values get NA rank.
+// Returns NA vector if the payload is not arrangeable.
+func (v *vector) PercentRank() Vector {
+	minRanks, na := v.MinRank().Integers()
+
+	counts := make([]int, v.length)
+	for _, group := range v.windowGroups() {
+		count := 0
+		for _, idx := range group {
+			if !na[idx-1] {
+				count++
+			}
+		}
+		for _, idx := range group {
+			counts[idx-1] = count
+		}
+	}
+
+	data := make([]float64, v.length)
+	for i := range data {
+		if na[i] {
+			continue
+		}
+
+		if counts[i] > 1 {
+			data[i] = float64(minRanks[i]-1) / float64(counts[i]-1)
+		}
+	}
+
+	return FloatWithNA(data, na, OptionVectorName(v.Name()+"_percent_rank"))
+}
+
+func (v *vector) shift(n int) Vector {
+	indices := make([]int, v.length)
+
+	for _, group := range v.windowGroups() {
+		for i, idx := range group {
+			src := i - n
+			if src >= 0 && src < len(group) {
+				indices[idx-1] = group[src]
+			}
+		}
+	}
+
+	return v.ByIndices(indices)
+}
+
+// windowGroups returns indices of the vector's groups or one group containing all elements if the vector is not
+// grouped.
+func (v *vector) windowGroups() [][]int {
+	if v.IsGrouped() {
+		return v.groupIndex
+	}
+
+	return [][]int{incIndices(indicesArray(v.length))}
+}
+
+// rankIntegers calls rankFn for every group and places the results to the positions of the corresponding elements.
+// rankFn receives positions (starting from zero) of non-NA elements ordered by value with ties broken by position,
+// dense ranks and NA-flags of the group elements.
+func (v *vector) rankIntegers(rankFn func(order []int, dense []int, isNA []bool) []int, columnPostfix string) Vector {
+	if _, ok := v.Payload().(Arrangeable); !ok {
+		return NA(v.length).SetName(v.Name() + columnPostfix)
+	}
+
+	data := make([]int, v.length)
+	na := trueBooleanArr(v.length)
+
+	for _, group := range v.windowGroups() {
+		order, dense, isNA := rankOrder(v.ByIndices(group))
+		ranks := rankFn(order, dense, isNA)
+
+		for i, idx := range group {
+			if !isNA[i] {
+				data[idx-1] = ranks[i]
+				na[idx-1] = false
+			}
+		}
+	}
+
+	return IntegerWithNA(data, na, OptionVectorName(v.Name()+columnPostfix))
+}
+
+func rankOrder(vec Vector) ([]int, []int, []bool) {
+	indices, ranks := vec.SortedIndicesWithRanks()
+	isNA := vec.IsNA()
+
+	order := make([]int, 0, vec.Len())
+	dense := make([]int, vec.Len())
+
+	rank := 0
+	for i, idx := range indices {
+		if isNA[idx-1] {
+			continue
+		}
+
+		if i == 0 || ranks[i] != ranks[i-1] {
+			rank++
+		}
+
+		order = append(order, idx-1)
+		dense[idx-1] = rank
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if dense[order[i]] == dense[order[j]] {
+			return order[i] < order[j]
+		}
+
+		return dense[order[i]] < dense[order[j]]
+	})
+
+	return order, dense, isNA
+}
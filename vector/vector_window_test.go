@@ -0,0 +1,240 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVector_Lag(t *testing.T) {
+	testData := []struct {
+		name     string
+		vec      Vector
+		n        int
+		expected Vector
+	}{
+		{
+			name:     "regular",
+			vec:      Integer([]int{1, 2, 3, 4, 5}),
+			n:        2,
+			expected: IntegerWithNA([]int{0, 0, 1, 2, 3}, []bool{true, true, false, false, false}),
+		},
+		{
+			name:     "with na",
+			vec:      StringWithNA([]string{"a", "", "c"}, []bool{false, true, false}),
+			n:        1,
+			expected: StringWithNA([]string{"", "a", ""}, []bool{true, false, true}),
+		},
+		{
+			name:     "zero",
+			vec:      Integer([]int{1, 2, 3}),
+			n:        0,
+			expected: Integer([]int{1, 2, 3}),
+		},
+		{
+			name:     "bigger than length",
+			vec:      Float([]float64{1, 2, 3}),
+			n:        5,
+			expected: FloatWithNA([]float64{0, 0, 0}, []bool{true, true, true}),
+		},
+		{
+			name: "grouped",
+			vec: Integer([]int{1, 2, 3, 4, 5, 6}).
+				GroupByIndices([][]int{{1, 3, 5}, {2, 4, 6}}),
+			n:        1,
+			expected: IntegerWithNA([]int{0, 0, 1, 2, 3, 4}, []bool{true, true, false, false, false, false}),
+		},
+		{
+			name:     "na",
+			vec:      NA(3),
+			n:        1,
+			expected: NA(3),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			lagged := data.vec.Lag(data.n)
+
+			if !CompareVectorsForTest(lagged, data.expected) {
+				t.Error(fmt.Sprintf("Lagged vector (%v) does not match expected (%v)", lagged, data.expected))
+			}
+		})
+	}
+}
+
+func TestVector_Lead(t *testing.T) {
+	testData := []struct {
+		name     string
+		vec      Vector
+		n        int
+		expected Vector
+	}{
+		{
+			name:     "regular",
+			vec:      Integer([]int{1, 2, 3, 4, 5}),
+			n:        2,
+			expected: IntegerWithNA([]int{3, 4, 5, 0, 0}, []bool{false, false, false, true, true}),
+		},
+		{
+			name: "grouped",
+			vec: Integer([]int{1, 2, 3, 4, 5, 6}).
+				GroupByIndices([][]int{{1, 3, 5}, {2, 4, 6}}),
+			n:        1,
+			expected: IntegerWithNA([]int{3, 4, 5, 6, 0, 0}, []bool{false, false, false, false, true, true}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			led := data.vec.Lead(data.n)
+
+			if !CompareVectorsForTest(led, data.expected) {
+				t.Error(fmt.Sprintf("Led vector (%v) does not match expected (%v)", led, data.expected))
+			}
+		})
+	}
+}
+
+func TestVector_RowNumber(t *testing.T) {
+	testData := []struct {
+		name     string
+		vec      Vector
+		expected Vector
+	}{
+		{
+			name:     "regular",
+			vec:      String([]string{"c", "a", "b"}),
+			expected: Integer([]int{1, 2, 3}),
+		},
+		{
+			name:     "grouped",
+			vec:      Integer([]int{5, 4, 3, 2, 1}).GroupByIndices([][]int{{1, 2, 4}, {3, 5}}),
+			expected: Integer([]int{1, 2, 1, 3, 2}),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			rowNumbers := data.vec.RowNumber()
+
+			if !CompareVectorsForTest(rowNumbers, data.expected) {
+				t.Error(fmt.Sprintf("Row numbers (%v) do not match expected (%v)", rowNumbers, data.expected))
+			}
+		})
+	}
+}
+
+func TestVector_Ranks(t *testing.T) {
+	vec := IntegerWithNA([]int{30, 10, 20, 10, 0, 40}, []bool{false, false, false, false, true, false})
+	grouped := Integer([]int{3, 1, 1, 2, 5, 5}).GroupByIndices([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	testData := []struct {
+		name     string
+		fn       func(Vector) Vector
+		vec      Vector
+		expected Vector
+	}{
+		{
+			name:     "min rank",
+			fn:       Vector.MinRank,
+			vec:      vec,
+			expected: IntegerWithNA([]int{4, 1, 3, 1, 0, 5}, []bool{false, false, false, false, true, false}),
+		},
+		{
+			name:     "min rank grouped",
+			fn:       Vector.MinRank,
+			vec:      grouped,
+			expected: Integer([]int{3, 1, 1, 1, 2, 2}),
+		},
+		{
+			name:     "dense rank",
+			fn:       Vector.DenseRank,
+			vec:      vec,
+			expected: IntegerWithNA([]int{3, 1, 2, 1, 0, 4}, []bool{false, false, false, false, true, false}),
+		},
+		{
+			name:     "dense rank grouped",
+			fn:       Vector.DenseRank,
+			vec:      grouped,
+			expected: Integer([]int{2, 1, 1, 1, 2, 2}),
+		},
+		{
+			name:     "percent rank",
+			fn:       Vector.PercentRank,
+			vec:      vec,
+			expected: FloatWithNA([]float64{0.75, 0, 0.5, 0, 0, 1}, []bool{false, false, false, false, true, false}),
+		},
+		{
+			name:     "percent rank grouped",
+			fn:       Vector.PercentRank,
+			vec:      grouped,
+			expected: Float([]float64{1, 0, 0, 0, 0.5, 0.5}),
+		},
+		{
+			name:     "non-arrangeable",
+			fn:       Vector.MinRank,
+			vec:      Complex([]complex128{1, 2}),
+			expected: NA(2),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			ranks := data.fn(data.vec)
+
+			if !CompareVectorsForTest(ranks, data.expected) {
+				t.Error(fmt.Sprintf("Ranks (%v) do not match expected (%v)", ranks, data.expected))
+			}
+		})
+	}
+}
+
+func TestVector_Ntile(t *testing.T) {
+	testData := []struct {
+		name     string
+		vec      Vector
+		k        int
+		expected Vector
+	}{
+		{
+			name:     "regular",
+			vec:      Integer([]int{5, 1, 4, 2, 3}),
+			k:        2,
+			expected: Integer([]int{2, 1, 2, 1, 1}),
+		},
+		{
+			name:     "ties",
+			vec:      Integer([]int{1, 1, 1, 1}),
+			k:        2,
+			expected: Integer([]int{1, 1, 2, 2}),
+		},
+		{
+			name:     "with na",
+			vec:      IntegerWithNA([]int{4, 0, 3, 2, 1}, []bool{false, true, false, false, false}),
+			k:        4,
+			expected: IntegerWithNA([]int{4, 0, 3, 2, 1}, []bool{false, true, false, false, false}),
+		},
+		{
+			name:     "grouped",
+			vec:      Integer([]int{1, 2, 3, 4, 5, 6}).GroupByIndices([][]int{{1, 3, 5}, {2, 4, 6}}),
+			k:        3,
+			expected: Integer([]int{1, 1, 2, 2, 3, 3}),
+		},
+		{
+			name:     "zero",
+			vec:      Integer([]int{1, 2}),
+			k:        0,
+			expected: NA(2),
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			tiles := data.vec.Ntile(data.k)
+
+			if !CompareVectorsForTest(tiles, data.expected) {
+				t.Error(fmt.Sprintf("Tiles (%v) do not match expected (%v)", tiles, data.expected))
+			}
+		})
+	}
+}